@@ -24,11 +24,114 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+// How the value of an item is encoded. Values of data types other than STRING are only written by the RPCs
+// of their type, Set, Add, CompareAndSwap and MultiSet refuse them with WRONG_KIND. Get returns them as opaque bytes.
+type Kind int32
+
+const (
+	Kind_STRING       Kind = 0
+	Kind_HASH         Kind = 1
+	Kind_LIST         Kind = 2
+	Kind_SORTED_SET   Kind = 3
+	Kind_LOCK         Kind = 4
+	Kind_TOKEN_BUCKET Kind = 5
+	Kind_HYPERLOGLOG  Kind = 6
+	Kind_BLOOM        Kind = 7
+)
+
+var Kind_name = map[int32]string{
+	0: "STRING",
+	1: "HASH",
+	2: "LIST",
+	3: "SORTED_SET",
+	4: "LOCK",
+	5: "TOKEN_BUCKET",
+	6: "HYPERLOGLOG",
+	7: "BLOOM",
+}
+
+var Kind_value = map[string]int32{
+	"STRING":       0,
+	"HASH":         1,
+	"LIST":         2,
+	"SORTED_SET":   3,
+	"LOCK":         4,
+	"TOKEN_BUCKET": 5,
+	"HYPERLOGLOG":  6,
+	"BLOOM":        7,
+}
+
+func (x Kind) String() string {
+	return proto.EnumName(Kind_name, int32(x))
+}
+
+func (Kind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{0}
+}
+
+type WatchEvent_Type int32
+
+const (
+	WatchEvent_SET    WatchEvent_Type = 0
+	WatchEvent_DELETE WatchEvent_Type = 1
+	WatchEvent_EXPIRE WatchEvent_Type = 2
+)
+
+var WatchEvent_Type_name = map[int32]string{
+	0: "SET",
+	1: "DELETE",
+	2: "EXPIRE",
+}
+
+var WatchEvent_Type_value = map[string]int32{
+	"SET":    0,
+	"DELETE": 1,
+	"EXPIRE": 2,
+}
+
+func (x WatchEvent_Type) String() string {
+	return proto.EnumName(WatchEvent_Type_name, int32(x))
+}
+
+func (WatchEvent_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{19, 0}
+}
+
+type MemberUpdate_State int32
+
+const (
+	MemberUpdate_ALIVE   MemberUpdate_State = 0
+	MemberUpdate_SUSPECT MemberUpdate_State = 1
+	MemberUpdate_DEAD    MemberUpdate_State = 2
+)
+
+var MemberUpdate_State_name = map[int32]string{
+	0: "ALIVE",
+	1: "SUSPECT",
+	2: "DEAD",
+}
+
+var MemberUpdate_State_value = map[string]int32{
+	"ALIVE":   0,
+	"SUSPECT": 1,
+	"DEAD":    2,
+}
+
+func (x MemberUpdate_State) String() string {
+	return proto.EnumName(MemberUpdate_State_name, int32(x))
+}
+
+func (MemberUpdate_State) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{62, 0}
+}
+
 type Item struct {
 	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	Value                []byte   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	LastUpdate           uint32   `protobuf:"varint,3,opt,name=lastUpdate,proto3" json:"lastUpdate,omitempty"`
+	LastUpdate           uint64   `protobuf:"varint,3,opt,name=lastUpdate,proto3" json:"lastUpdate,omitempty"`
 	Expiration           uint32   `protobuf:"varint,4,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	Tags                 []string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	Kind                 Kind     `protobuf:"varint,6,opt,name=kind,proto3,enum=definitions.Kind" json:"kind,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -73,7 +176,7 @@ func (m *Item) GetValue() []byte {
 	return nil
 }
 
-func (m *Item) GetLastUpdate() uint32 {
+func (m *Item) GetLastUpdate() uint64 {
 	if m != nil {
 		return m.LastUpdate
 	}
@@ -87,8 +190,23 @@ func (m *Item) GetExpiration() uint32 {
 	return 0
 }
 
+func (m *Item) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *Item) GetKind() Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return Kind_STRING
+}
+
 type AddRequest struct {
 	Item                 *Item    `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -126,8 +244,16 @@ func (m *AddRequest) GetItem() *Item {
 	return nil
 }
 
+func (m *AddRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
 type CompareAndSwapRequest struct {
 	Item                 *Item    `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -165,8 +291,17 @@ func (m *CompareAndSwapRequest) GetItem() *Item {
 	return nil
 }
 
+func (m *CompareAndSwapRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
 type SetRequest struct {
 	Item                 *Item    `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Lease                uint64   `protobuf:"varint,3,opt,name=lease,proto3" json:"lease,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -204,8 +339,23 @@ func (m *SetRequest) GetItem() *Item {
 	return nil
 }
 
+func (m *SetRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *SetRequest) GetLease() uint64 {
+	if m != nil {
+		return m.Lease
+	}
+	return 0
+}
+
 type DeleteRequest struct {
 	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -243,7 +393,18 @@ func (m *DeleteRequest) GetKey() string {
 	return ""
 }
 
+func (m *DeleteRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
 type DeleteAllRequest struct {
+	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Delay                uint32   `protobuf:"varint,2,opt,name=delay,proto3" json:"delay,omitempty"`
+	Local                bool     `protobuf:"varint,3,opt,name=local,proto3" json:"local,omitempty"`
+	FlushAt              int64    `protobuf:"varint,4,opt,name=flushAt,proto3" json:"flushAt,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -274,8 +435,39 @@ func (m *DeleteAllRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DeleteAllRequest proto.InternalMessageInfo
 
+func (m *DeleteAllRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *DeleteAllRequest) GetDelay() uint32 {
+	if m != nil {
+		return m.Delay
+	}
+	return 0
+}
+
+func (m *DeleteAllRequest) GetLocal() bool {
+	if m != nil {
+		return m.Local
+	}
+	return false
+}
+
+func (m *DeleteAllRequest) GetFlushAt() int64 {
+	if m != nil {
+		return m.FlushAt
+	}
+	return 0
+}
+
 type GetRequest struct {
 	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Load                 bool     `protobuf:"varint,3,opt,name=load,proto3" json:"load,omitempty"`
+	Lease                bool     `protobuf:"varint,4,opt,name=lease,proto3" json:"lease,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -313,9 +505,31 @@ func (m *GetRequest) GetKey() string {
 	return ""
 }
 
+func (m *GetRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *GetRequest) GetLoad() bool {
+	if m != nil {
+		return m.Load
+	}
+	return false
+}
+
+func (m *GetRequest) GetLease() bool {
+	if m != nil {
+		return m.Lease
+	}
+	return false
+}
+
 type Reply struct {
 	Message              string   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 	Item                 *Item    `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	Lease                uint64   `protobuf:"varint,3,opt,name=lease,proto3" json:"lease,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -360,6 +574,13 @@ func (m *Reply) GetItem() *Item {
 	return nil
 }
 
+func (m *Reply) GetLease() uint64 {
+	if m != nil {
+		return m.Lease
+	}
+	return 0
+}
+
 type AddServerRequest struct {
 	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -399,6 +620,69 @@ func (m *AddServerRequest) GetAddress() string {
 	return ""
 }
 
+type HandOffRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Entry                []byte   `protobuf:"bytes,2,opt,name=entry,proto3" json:"entry,omitempty"`
+	Expiration           uint32   `protobuf:"varint,3,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	Namespace            string   `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HandOffRequest) Reset()         { *m = HandOffRequest{} }
+func (m *HandOffRequest) String() string { return proto.CompactTextString(m) }
+func (*HandOffRequest) ProtoMessage()    {}
+func (*HandOffRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{9}
+}
+
+func (m *HandOffRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HandOffRequest.Unmarshal(m, b)
+}
+func (m *HandOffRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HandOffRequest.Marshal(b, m, deterministic)
+}
+func (m *HandOffRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HandOffRequest.Merge(m, src)
+}
+func (m *HandOffRequest) XXX_Size() int {
+	return xxx_messageInfo_HandOffRequest.Size(m)
+}
+func (m *HandOffRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HandOffRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HandOffRequest proto.InternalMessageInfo
+
+func (m *HandOffRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *HandOffRequest) GetEntry() []byte {
+	if m != nil {
+		return m.Entry
+	}
+	return nil
+}
+
+func (m *HandOffRequest) GetExpiration() uint32 {
+	if m != nil {
+		return m.Expiration
+	}
+	return 0
+}
+
+func (m *HandOffRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
 type DropServerRequest struct {
 	Server               string   `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -410,7 +694,7 @@ func (m *DropServerRequest) Reset()         { *m = DropServerRequest{} }
 func (m *DropServerRequest) String() string { return proto.CompactTextString(m) }
 func (*DropServerRequest) ProtoMessage()    {}
 func (*DropServerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_671b7d4d1004a799, []int{9}
+	return fileDescriptor_671b7d4d1004a799, []int{10}
 }
 
 func (m *DropServerRequest) XXX_Unmarshal(b []byte) error {
@@ -448,7 +732,7 @@ func (m *GetServersRequest) Reset()         { *m = GetServersRequest{} }
 func (m *GetServersRequest) String() string { return proto.CompactTextString(m) }
 func (*GetServersRequest) ProtoMessage()    {}
 func (*GetServersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_671b7d4d1004a799, []int{10}
+	return fileDescriptor_671b7d4d1004a799, []int{11}
 }
 
 func (m *GetServersRequest) XXX_Unmarshal(b []byte) error {
@@ -470,17 +754,18 @@ func (m *GetServersRequest) XXX_DiscardUnknown() {
 var xxx_messageInfo_GetServersRequest proto.InternalMessageInfo
 
 type ServerList struct {
-	Servers              []string `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Servers              []string          `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"`
+	Namespaces           map[string]uint64 `protobuf:"bytes,3,rep,name=namespaces,proto3" json:"namespaces,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *ServerList) Reset()         { *m = ServerList{} }
 func (m *ServerList) String() string { return proto.CompactTextString(m) }
 func (*ServerList) ProtoMessage()    {}
 func (*ServerList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_671b7d4d1004a799, []int{11}
+	return fileDescriptor_671b7d4d1004a799, []int{12}
 }
 
 func (m *ServerList) XXX_Unmarshal(b []byte) error {
@@ -508,137 +793,3564 @@ func (m *ServerList) GetServers() []string {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*Item)(nil), "definitions.Item")
-	proto.RegisterType((*AddRequest)(nil), "definitions.AddRequest")
-	proto.RegisterType((*CompareAndSwapRequest)(nil), "definitions.CompareAndSwapRequest")
-	proto.RegisterType((*SetRequest)(nil), "definitions.SetRequest")
-	proto.RegisterType((*DeleteRequest)(nil), "definitions.DeleteRequest")
-	proto.RegisterType((*DeleteAllRequest)(nil), "definitions.DeleteAllRequest")
-	proto.RegisterType((*GetRequest)(nil), "definitions.GetRequest")
-	proto.RegisterType((*Reply)(nil), "definitions.Reply")
-	proto.RegisterType((*AddServerRequest)(nil), "definitions.AddServerRequest")
-	proto.RegisterType((*DropServerRequest)(nil), "definitions.DropServerRequest")
-	proto.RegisterType((*GetServersRequest)(nil), "definitions.GetServersRequest")
-	proto.RegisterType((*ServerList)(nil), "definitions.ServerList")
+func (m *ServerList) GetNamespaces() map[string]uint64 {
+	if m != nil {
+		return m.Namespaces
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("grpc/definitions/definitions.proto", fileDescriptor_671b7d4d1004a799) }
-
-var fileDescriptor_671b7d4d1004a799 = []byte{
-	// 440 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x5b, 0x8b, 0xd3, 0x40,
-	0x14, 0xde, 0x6c, 0xba, 0x2d, 0x3d, 0xeb, 0x42, 0x3b, 0xde, 0x42, 0xc1, 0x52, 0x07, 0x94, 0x82,
-	0xb2, 0xc2, 0xd6, 0x27, 0x1f, 0x64, 0xa3, 0x0b, 0x51, 0xf0, 0x29, 0xc1, 0x1f, 0x30, 0x76, 0x8e,
-	0x35, 0x98, 0xcb, 0x38, 0x33, 0xad, 0xf6, 0x9f, 0xfb, 0x28, 0x93, 0x4b, 0x73, 0x6b, 0x5c, 0xfa,
-	0x96, 0x73, 0xf9, 0xce, 0xf7, 0xcd, 0xf9, 0x0e, 0x01, 0xba, 0x91, 0x62, 0xfd, 0x86, 0xe3, 0xf7,
-	0x30, 0x09, 0x75, 0x98, 0x26, 0xaa, 0xfe, 0x7d, 0x2d, 0x64, 0xaa, 0x53, 0x72, 0x59, 0x4b, 0xd1,
-	0x04, 0x06, 0x9f, 0x35, 0xc6, 0x64, 0x02, 0xf6, 0x4f, 0xdc, 0x3b, 0xd6, 0xc2, 0x5a, 0x8e, 0x7d,
-	0xf3, 0x49, 0x1e, 0xc1, 0xc5, 0x8e, 0x45, 0x5b, 0x74, 0xce, 0x17, 0xd6, 0xf2, 0x81, 0x9f, 0x07,
-	0x64, 0x0e, 0x10, 0x31, 0xa5, 0xbf, 0x0a, 0xce, 0x34, 0x3a, 0xf6, 0xc2, 0x5a, 0x5e, 0xf9, 0xb5,
-	0x8c, 0xa9, 0xe3, 0x1f, 0x11, 0x4a, 0x66, 0xc6, 0x3b, 0x83, 0xbc, 0x5e, 0x65, 0xe8, 0x0a, 0xc0,
-	0xe5, 0xdc, 0xc7, 0x5f, 0x5b, 0x54, 0x9a, 0xbc, 0x80, 0x41, 0xa8, 0x31, 0xce, 0x68, 0x2f, 0x6f,
-	0xa6, 0xd7, 0x75, 0xb1, 0x46, 0x96, 0x9f, 0x95, 0xe9, 0x7b, 0x78, 0xfc, 0x31, 0x8d, 0x05, 0x93,
-	0xe8, 0x26, 0x3c, 0xf8, 0xcd, 0xc4, 0x89, 0xf8, 0x15, 0x40, 0x80, 0xfa, 0x44, 0xd0, 0x73, 0xb8,
-	0xba, 0xc3, 0x08, 0x35, 0x96, 0xb8, 0xce, 0x8a, 0x28, 0x81, 0x49, 0xde, 0xe2, 0x46, 0x51, 0xd1,
-	0x45, 0xe7, 0x00, 0x5e, 0xc5, 0xd5, 0xc5, 0x7c, 0x82, 0x0b, 0x1f, 0x45, 0xb4, 0x27, 0x0e, 0x8c,
-	0x62, 0x54, 0x8a, 0x6d, 0xb0, 0x28, 0x97, 0xe1, 0x41, 0xe0, 0xf9, 0xff, 0x05, 0xbe, 0x86, 0x89,
-	0xcb, 0x79, 0x80, 0x72, 0x87, 0xb2, 0xe4, 0x73, 0x60, 0xc4, 0x38, 0x97, 0xa8, 0x54, 0x39, 0xb4,
-	0x08, 0xe9, 0x2b, 0x98, 0xde, 0xc9, 0x54, 0x34, 0xdb, 0x9f, 0xc0, 0x50, 0x65, 0x89, 0xa2, 0xbb,
-	0x88, 0xe8, 0x43, 0x98, 0x7a, 0xa8, 0xf3, 0x5e, 0x55, 0xbe, 0xec, 0xa5, 0xd9, 0xa2, 0xc9, 0x7c,
-	0x09, 0x73, 0xa6, 0xbc, 0xd9, 0x30, 0xd9, 0x86, 0xa9, 0x08, 0x6f, 0xfe, 0xda, 0x30, 0xe2, 0x72,
-	0xcd, 0xd6, 0x3f, 0x90, 0xbc, 0x05, 0xdb, 0xe5, 0x9c, 0x3c, 0x6d, 0xbc, 0xa1, 0x3a, 0x80, 0x19,
-	0x69, 0x14, 0xb2, 0xc5, 0xd0, 0x33, 0x83, 0x0a, 0x50, 0xb7, 0x50, 0x95, 0x83, 0x3d, 0xa8, 0x77,
-	0x30, 0xcc, 0xdd, 0x20, 0xb3, 0x46, 0xbd, 0xe1, 0x62, 0x0f, 0xf6, 0x16, 0xc6, 0x07, 0x27, 0xc9,
-	0xb3, 0x23, 0xf0, 0xca, 0xe1, 0x7e, 0xcd, 0x5e, 0x47, 0xb3, 0x77, 0x9f, 0xe6, 0x5b, 0x18, 0x1f,
-	0x3c, 0x6c, 0xf1, 0xb6, 0xbd, 0xed, 0x99, 0xe0, 0x65, 0xf7, 0x56, 0x58, 0x45, 0xe6, 0x6d, 0xfa,
-	0xa6, 0x87, 0xb3, 0xf6, 0x4a, 0x4b, 0x3b, 0xe9, 0x19, 0xf9, 0x00, 0x50, 0x1d, 0x48, 0x6b, 0x50,
-	0xe7, 0x72, 0x8e, 0x8b, 0xf9, 0x36, 0xcc, 0xfe, 0x30, 0xab, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x82, 0xd0, 0x5e, 0xf5, 0x87, 0x04, 0x00, 0x00,
+type MultiGetRequest struct {
+	Keys                 []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// DrcacheClient is the client API for Drcache service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type DrcacheClient interface {
-	Add(ctx context.Context, in *AddRequest, opts ...grpc.CallOption) (*Reply, error)
-	Set(ctx context.Context, in *SetRequest, opts ...grpc.CallOption) (*Reply, error)
-	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*Reply, error)
-	DeleteAll(ctx context.Context, in *DeleteAllRequest, opts ...grpc.CallOption) (*Reply, error)
-	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Reply, error)
-	AddServer(ctx context.Context, in *AddServerRequest, opts ...grpc.CallOption) (*Reply, error)
-	GetServers(ctx context.Context, in *GetServersRequest, opts ...grpc.CallOption) (*ServerList, error)
-	DropServer(ctx context.Context, in *DropServerRequest, opts ...grpc.CallOption) (*Reply, error)
+func (m *MultiGetRequest) Reset()         { *m = MultiGetRequest{} }
+func (m *MultiGetRequest) String() string { return proto.CompactTextString(m) }
+func (*MultiGetRequest) ProtoMessage()    {}
+func (*MultiGetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{13}
 }
 
-type drcacheClient struct {
-	cc *grpc.ClientConn
+func (m *MultiGetRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiGetRequest.Unmarshal(m, b)
 }
-
-func NewDrcacheClient(cc *grpc.ClientConn) DrcacheClient {
-	return &drcacheClient{cc}
+func (m *MultiGetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiGetRequest.Marshal(b, m, deterministic)
+}
+func (m *MultiGetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiGetRequest.Merge(m, src)
+}
+func (m *MultiGetRequest) XXX_Size() int {
+	return xxx_messageInfo_MultiGetRequest.Size(m)
+}
+func (m *MultiGetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiGetRequest.DiscardUnknown(m)
 }
 
-func (c *drcacheClient) Add(ctx context.Context, in *AddRequest, opts ...grpc.CallOption) (*Reply, error) {
-	out := new(Reply)
-	err := c.cc.Invoke(ctx, "/definitions.drcache/Add", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MultiGetRequest proto.InternalMessageInfo
+
+func (m *MultiGetRequest) GetKeys() []string {
+	if m != nil {
+		return m.Keys
 	}
-	return out, nil
+	return nil
 }
 
-func (c *drcacheClient) Set(ctx context.Context, in *SetRequest, opts ...grpc.CallOption) (*Reply, error) {
-	out := new(Reply)
-	err := c.cc.Invoke(ctx, "/definitions.drcache/Set", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MultiGetRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	return out, nil
+	return ""
 }
 
-func (c *drcacheClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*Reply, error) {
-	out := new(Reply)
-	err := c.cc.Invoke(ctx, "/definitions.drcache/Delete", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MultiSetRequest struct {
+	Items                []*Item  `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *drcacheClient) DeleteAll(ctx context.Context, in *DeleteAllRequest, opts ...grpc.CallOption) (*Reply, error) {
-	out := new(Reply)
-	err := c.cc.Invoke(ctx, "/definitions.drcache/DeleteAll", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MultiSetRequest) Reset()         { *m = MultiSetRequest{} }
+func (m *MultiSetRequest) String() string { return proto.CompactTextString(m) }
+func (*MultiSetRequest) ProtoMessage()    {}
+func (*MultiSetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{14}
 }
 
-func (c *drcacheClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Reply, error) {
-	out := new(Reply)
-	err := c.cc.Invoke(ctx, "/definitions.drcache/Get", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MultiSetRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSetRequest.Unmarshal(m, b)
+}
+func (m *MultiSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSetRequest.Marshal(b, m, deterministic)
+}
+func (m *MultiSetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSetRequest.Merge(m, src)
+}
+func (m *MultiSetRequest) XXX_Size() int {
+	return xxx_messageInfo_MultiSetRequest.Size(m)
+}
+func (m *MultiSetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSetRequest proto.InternalMessageInfo
+
+func (m *MultiSetRequest) GetItems() []*Item {
+	if m != nil {
+		return m.Items
 	}
-	return out, nil
+	return nil
 }
 
-func (c *drcacheClient) AddServer(ctx context.Context, in *AddServerRequest, opts ...grpc.CallOption) (*Reply, error) {
-	out := new(Reply)
-	err := c.cc.Invoke(ctx, "/definitions.drcache/AddServer", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MultiSetRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	return out, nil
+	return ""
+}
+
+type MultiDeleteRequest struct {
+	Keys                 []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MultiDeleteRequest) Reset()         { *m = MultiDeleteRequest{} }
+func (m *MultiDeleteRequest) String() string { return proto.CompactTextString(m) }
+func (*MultiDeleteRequest) ProtoMessage()    {}
+func (*MultiDeleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{15}
+}
+
+func (m *MultiDeleteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiDeleteRequest.Unmarshal(m, b)
+}
+func (m *MultiDeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiDeleteRequest.Marshal(b, m, deterministic)
+}
+func (m *MultiDeleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiDeleteRequest.Merge(m, src)
+}
+func (m *MultiDeleteRequest) XXX_Size() int {
+	return xxx_messageInfo_MultiDeleteRequest.Size(m)
+}
+func (m *MultiDeleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiDeleteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiDeleteRequest proto.InternalMessageInfo
+
+func (m *MultiDeleteRequest) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *MultiDeleteRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type KeyResult struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Item                 *Item    `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	Error                string   `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Code                 uint32   `protobuf:"varint,4,opt,name=code,proto3" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *KeyResult) Reset()         { *m = KeyResult{} }
+func (m *KeyResult) String() string { return proto.CompactTextString(m) }
+func (*KeyResult) ProtoMessage()    {}
+func (*KeyResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{16}
+}
+
+func (m *KeyResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KeyResult.Unmarshal(m, b)
+}
+func (m *KeyResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KeyResult.Marshal(b, m, deterministic)
+}
+func (m *KeyResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyResult.Merge(m, src)
+}
+func (m *KeyResult) XXX_Size() int {
+	return xxx_messageInfo_KeyResult.Size(m)
+}
+func (m *KeyResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyResult proto.InternalMessageInfo
+
+func (m *KeyResult) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *KeyResult) GetItem() *Item {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+func (m *KeyResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *KeyResult) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+type MultiReply struct {
+	Results              []*KeyResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *MultiReply) Reset()         { *m = MultiReply{} }
+func (m *MultiReply) String() string { return proto.CompactTextString(m) }
+func (*MultiReply) ProtoMessage()    {}
+func (*MultiReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{17}
+}
+
+func (m *MultiReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiReply.Unmarshal(m, b)
+}
+func (m *MultiReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiReply.Marshal(b, m, deterministic)
+}
+func (m *MultiReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiReply.Merge(m, src)
+}
+func (m *MultiReply) XXX_Size() int {
+	return xxx_messageInfo_MultiReply.Size(m)
+}
+func (m *MultiReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiReply proto.InternalMessageInfo
+
+func (m *MultiReply) GetResults() []*KeyResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type WatchRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Prefix               bool     `protobuf:"varint,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Local                bool     `protobuf:"varint,3,opt,name=local,proto3" json:"local,omitempty"`
+	Namespace            string   `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchRequest) Reset()         { *m = WatchRequest{} }
+func (m *WatchRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchRequest) ProtoMessage()    {}
+func (*WatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{18}
+}
+
+func (m *WatchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchRequest.Unmarshal(m, b)
+}
+func (m *WatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchRequest.Marshal(b, m, deterministic)
+}
+func (m *WatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchRequest.Merge(m, src)
+}
+func (m *WatchRequest) XXX_Size() int {
+	return xxx_messageInfo_WatchRequest.Size(m)
+}
+func (m *WatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchRequest proto.InternalMessageInfo
+
+func (m *WatchRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *WatchRequest) GetPrefix() bool {
+	if m != nil {
+		return m.Prefix
+	}
+	return false
+}
+
+func (m *WatchRequest) GetLocal() bool {
+	if m != nil {
+		return m.Local
+	}
+	return false
+}
+
+func (m *WatchRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type WatchEvent struct {
+	Type                 WatchEvent_Type `protobuf:"varint,1,opt,name=type,proto3,enum=definitions.WatchEvent_Type" json:"type,omitempty"`
+	Item                 *Item           `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *WatchEvent) Reset()         { *m = WatchEvent{} }
+func (m *WatchEvent) String() string { return proto.CompactTextString(m) }
+func (*WatchEvent) ProtoMessage()    {}
+func (*WatchEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{19}
+}
+
+func (m *WatchEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchEvent.Unmarshal(m, b)
+}
+func (m *WatchEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchEvent.Marshal(b, m, deterministic)
+}
+func (m *WatchEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchEvent.Merge(m, src)
+}
+func (m *WatchEvent) XXX_Size() int {
+	return xxx_messageInfo_WatchEvent.Size(m)
+}
+func (m *WatchEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchEvent proto.InternalMessageInfo
+
+func (m *WatchEvent) GetType() WatchEvent_Type {
+	if m != nil {
+		return m.Type
+	}
+	return WatchEvent_SET
+}
+
+func (m *WatchEvent) GetItem() *Item {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+type CounterRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Delta                uint64   `protobuf:"varint,2,opt,name=delta,proto3" json:"delta,omitempty"`
+	Create               bool     `protobuf:"varint,3,opt,name=create,proto3" json:"create,omitempty"`
+	Initial              int64    `protobuf:"varint,4,opt,name=initial,proto3" json:"initial,omitempty"`
+	Expiration           uint32   `protobuf:"varint,5,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	Namespace            string   `protobuf:"bytes,6,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CounterRequest) Reset()         { *m = CounterRequest{} }
+func (m *CounterRequest) String() string { return proto.CompactTextString(m) }
+func (*CounterRequest) ProtoMessage()    {}
+func (*CounterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{20}
+}
+
+func (m *CounterRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CounterRequest.Unmarshal(m, b)
+}
+func (m *CounterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CounterRequest.Marshal(b, m, deterministic)
+}
+func (m *CounterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CounterRequest.Merge(m, src)
+}
+func (m *CounterRequest) XXX_Size() int {
+	return xxx_messageInfo_CounterRequest.Size(m)
+}
+func (m *CounterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CounterRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CounterRequest proto.InternalMessageInfo
+
+func (m *CounterRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *CounterRequest) GetDelta() uint64 {
+	if m != nil {
+		return m.Delta
+	}
+	return 0
+}
+
+func (m *CounterRequest) GetCreate() bool {
+	if m != nil {
+		return m.Create
+	}
+	return false
+}
+
+func (m *CounterRequest) GetInitial() int64 {
+	if m != nil {
+		return m.Initial
+	}
+	return 0
+}
+
+func (m *CounterRequest) GetExpiration() uint32 {
+	if m != nil {
+		return m.Expiration
+	}
+	return 0
+}
+
+func (m *CounterRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type AppendRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value                []byte   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Namespace            string   `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AppendRequest) Reset()         { *m = AppendRequest{} }
+func (m *AppendRequest) String() string { return proto.CompactTextString(m) }
+func (*AppendRequest) ProtoMessage()    {}
+func (*AppendRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{21}
+}
+
+func (m *AppendRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AppendRequest.Unmarshal(m, b)
+}
+func (m *AppendRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AppendRequest.Marshal(b, m, deterministic)
+}
+func (m *AppendRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppendRequest.Merge(m, src)
+}
+func (m *AppendRequest) XXX_Size() int {
+	return xxx_messageInfo_AppendRequest.Size(m)
+}
+func (m *AppendRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppendRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AppendRequest proto.InternalMessageInfo
+
+func (m *AppendRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *AppendRequest) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *AppendRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type TouchRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Expiration           uint32   `protobuf:"varint,2,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	Namespace            string   `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TouchRequest) Reset()         { *m = TouchRequest{} }
+func (m *TouchRequest) String() string { return proto.CompactTextString(m) }
+func (*TouchRequest) ProtoMessage()    {}
+func (*TouchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{22}
+}
+
+func (m *TouchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TouchRequest.Unmarshal(m, b)
+}
+func (m *TouchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TouchRequest.Marshal(b, m, deterministic)
+}
+func (m *TouchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TouchRequest.Merge(m, src)
+}
+func (m *TouchRequest) XXX_Size() int {
+	return xxx_messageInfo_TouchRequest.Size(m)
+}
+func (m *TouchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TouchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TouchRequest proto.InternalMessageInfo
+
+func (m *TouchRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *TouchRequest) GetExpiration() uint32 {
+	if m != nil {
+		return m.Expiration
+	}
+	return 0
+}
+
+func (m *TouchRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type ScanRequest struct {
+	Cursor               string   `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Prefix               string   `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Pattern              string   `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Count                uint32   `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	Local                bool     `protobuf:"varint,5,opt,name=local,proto3" json:"local,omitempty"`
+	Namespace            string   `protobuf:"bytes,6,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ScanRequest) Reset()         { *m = ScanRequest{} }
+func (m *ScanRequest) String() string { return proto.CompactTextString(m) }
+func (*ScanRequest) ProtoMessage()    {}
+func (*ScanRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{23}
+}
+
+func (m *ScanRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScanRequest.Unmarshal(m, b)
+}
+func (m *ScanRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScanRequest.Marshal(b, m, deterministic)
+}
+func (m *ScanRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScanRequest.Merge(m, src)
+}
+func (m *ScanRequest) XXX_Size() int {
+	return xxx_messageInfo_ScanRequest.Size(m)
+}
+func (m *ScanRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScanRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScanRequest proto.InternalMessageInfo
+
+func (m *ScanRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *ScanRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *ScanRequest) GetPattern() string {
+	if m != nil {
+		return m.Pattern
+	}
+	return ""
+}
+
+func (m *ScanRequest) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ScanRequest) GetLocal() bool {
+	if m != nil {
+		return m.Local
+	}
+	return false
+}
+
+func (m *ScanRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type ScanReply struct {
+	Keys                 []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	Cursor               string   `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ScanReply) Reset()         { *m = ScanReply{} }
+func (m *ScanReply) String() string { return proto.CompactTextString(m) }
+func (*ScanReply) ProtoMessage()    {}
+func (*ScanReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{24}
+}
+
+func (m *ScanReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScanReply.Unmarshal(m, b)
+}
+func (m *ScanReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScanReply.Marshal(b, m, deterministic)
+}
+func (m *ScanReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScanReply.Merge(m, src)
+}
+func (m *ScanReply) XXX_Size() int {
+	return xxx_messageInfo_ScanReply.Size(m)
+}
+func (m *ScanReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScanReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScanReply proto.InternalMessageInfo
+
+func (m *ScanReply) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *ScanReply) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+type NamespaceRequest struct {
+	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Quota                uint64   `protobuf:"varint,2,opt,name=quota,proto3" json:"quota,omitempty"`
+	Local                bool     `protobuf:"varint,3,opt,name=local,proto3" json:"local,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NamespaceRequest) Reset()         { *m = NamespaceRequest{} }
+func (m *NamespaceRequest) String() string { return proto.CompactTextString(m) }
+func (*NamespaceRequest) ProtoMessage()    {}
+func (*NamespaceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{25}
+}
+
+func (m *NamespaceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NamespaceRequest.Unmarshal(m, b)
+}
+func (m *NamespaceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NamespaceRequest.Marshal(b, m, deterministic)
+}
+func (m *NamespaceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceRequest.Merge(m, src)
+}
+func (m *NamespaceRequest) XXX_Size() int {
+	return xxx_messageInfo_NamespaceRequest.Size(m)
+}
+func (m *NamespaceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceRequest proto.InternalMessageInfo
+
+func (m *NamespaceRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *NamespaceRequest) GetQuota() uint64 {
+	if m != nil {
+		return m.Quota
+	}
+	return 0
+}
+
+func (m *NamespaceRequest) GetLocal() bool {
+	if m != nil {
+		return m.Local
+	}
+	return false
+}
+
+type NamespaceStats struct {
+	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Quota                uint64   `protobuf:"varint,2,opt,name=quota,proto3" json:"quota,omitempty"`
+	Entries              int64    `protobuf:"varint,3,opt,name=entries,proto3" json:"entries,omitempty"`
+	Hits                 int64    `protobuf:"varint,4,opt,name=hits,proto3" json:"hits,omitempty"`
+	Misses               int64    `protobuf:"varint,5,opt,name=misses,proto3" json:"misses,omitempty"`
+	Evictions            int64    `protobuf:"varint,6,opt,name=evictions,proto3" json:"evictions,omitempty"`
+	Expirations          int64    `protobuf:"varint,7,opt,name=expirations,proto3" json:"expirations,omitempty"`
+	Bytes                uint64   `protobuf:"varint,8,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NamespaceStats) Reset()         { *m = NamespaceStats{} }
+func (m *NamespaceStats) String() string { return proto.CompactTextString(m) }
+func (*NamespaceStats) ProtoMessage()    {}
+func (*NamespaceStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{26}
+}
+
+func (m *NamespaceStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NamespaceStats.Unmarshal(m, b)
+}
+func (m *NamespaceStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NamespaceStats.Marshal(b, m, deterministic)
+}
+func (m *NamespaceStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceStats.Merge(m, src)
+}
+func (m *NamespaceStats) XXX_Size() int {
+	return xxx_messageInfo_NamespaceStats.Size(m)
+}
+func (m *NamespaceStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceStats proto.InternalMessageInfo
+
+func (m *NamespaceStats) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *NamespaceStats) GetQuota() uint64 {
+	if m != nil {
+		return m.Quota
+	}
+	return 0
+}
+
+func (m *NamespaceStats) GetEntries() int64 {
+	if m != nil {
+		return m.Entries
+	}
+	return 0
+}
+
+func (m *NamespaceStats) GetHits() int64 {
+	if m != nil {
+		return m.Hits
+	}
+	return 0
+}
+
+func (m *NamespaceStats) GetMisses() int64 {
+	if m != nil {
+		return m.Misses
+	}
+	return 0
+}
+
+func (m *NamespaceStats) GetEvictions() int64 {
+	if m != nil {
+		return m.Evictions
+	}
+	return 0
+}
+
+func (m *NamespaceStats) GetExpirations() int64 {
+	if m != nil {
+		return m.Expirations
+	}
+	return 0
+}
+
+func (m *NamespaceStats) GetBytes() uint64 {
+	if m != nil {
+		return m.Bytes
+	}
+	return 0
+}
+
+type NodeResult struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Error                string   `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Code                 uint32   `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	Affected             uint64   `protobuf:"varint,4,opt,name=affected,proto3" json:"affected,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodeResult) Reset()         { *m = NodeResult{} }
+func (m *NodeResult) String() string { return proto.CompactTextString(m) }
+func (*NodeResult) ProtoMessage()    {}
+func (*NodeResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{27}
+}
+
+func (m *NodeResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeResult.Unmarshal(m, b)
+}
+func (m *NodeResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeResult.Marshal(b, m, deterministic)
+}
+func (m *NodeResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeResult.Merge(m, src)
+}
+func (m *NodeResult) XXX_Size() int {
+	return xxx_messageInfo_NodeResult.Size(m)
+}
+func (m *NodeResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeResult proto.InternalMessageInfo
+
+func (m *NodeResult) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *NodeResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *NodeResult) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *NodeResult) GetAffected() uint64 {
+	if m != nil {
+		return m.Affected
+	}
+	return 0
+}
+
+type ClusterReply struct {
+	Nodes                []*NodeResult `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ClusterReply) Reset()         { *m = ClusterReply{} }
+func (m *ClusterReply) String() string { return proto.CompactTextString(m) }
+func (*ClusterReply) ProtoMessage()    {}
+func (*ClusterReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{28}
+}
+
+func (m *ClusterReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClusterReply.Unmarshal(m, b)
+}
+func (m *ClusterReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClusterReply.Marshal(b, m, deterministic)
+}
+func (m *ClusterReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterReply.Merge(m, src)
+}
+func (m *ClusterReply) XXX_Size() int {
+	return xxx_messageInfo_ClusterReply.Size(m)
+}
+func (m *ClusterReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterReply proto.InternalMessageInfo
+
+func (m *ClusterReply) GetNodes() []*NodeResult {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+type InvalidateTagRequest struct {
+	Tag                  string   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Local                bool     `protobuf:"varint,3,opt,name=local,proto3" json:"local,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InvalidateTagRequest) Reset()         { *m = InvalidateTagRequest{} }
+func (m *InvalidateTagRequest) String() string { return proto.CompactTextString(m) }
+func (*InvalidateTagRequest) ProtoMessage()    {}
+func (*InvalidateTagRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{29}
+}
+
+func (m *InvalidateTagRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InvalidateTagRequest.Unmarshal(m, b)
+}
+func (m *InvalidateTagRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InvalidateTagRequest.Marshal(b, m, deterministic)
+}
+func (m *InvalidateTagRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvalidateTagRequest.Merge(m, src)
+}
+func (m *InvalidateTagRequest) XXX_Size() int {
+	return xxx_messageInfo_InvalidateTagRequest.Size(m)
+}
+func (m *InvalidateTagRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvalidateTagRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvalidateTagRequest proto.InternalMessageInfo
+
+func (m *InvalidateTagRequest) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+func (m *InvalidateTagRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *InvalidateTagRequest) GetLocal() bool {
+	if m != nil {
+		return m.Local
+	}
+	return false
+}
+
+type StatsRequest struct {
+	Cluster              bool     `protobuf:"varint,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StatsRequest) Reset()         { *m = StatsRequest{} }
+func (m *StatsRequest) String() string { return proto.CompactTextString(m) }
+func (*StatsRequest) ProtoMessage()    {}
+func (*StatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{30}
+}
+
+func (m *StatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StatsRequest.Unmarshal(m, b)
+}
+func (m *StatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StatsRequest.Marshal(b, m, deterministic)
+}
+func (m *StatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatsRequest.Merge(m, src)
+}
+func (m *StatsRequest) XXX_Size() int {
+	return xxx_messageInfo_StatsRequest.Size(m)
+}
+func (m *StatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatsRequest proto.InternalMessageInfo
+
+func (m *StatsRequest) GetCluster() bool {
+	if m != nil {
+		return m.Cluster
+	}
+	return false
+}
+
+type NodeStats struct {
+	Address              string            `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Entries              int64             `protobuf:"varint,2,opt,name=entries,proto3" json:"entries,omitempty"`
+	Hits                 int64             `protobuf:"varint,3,opt,name=hits,proto3" json:"hits,omitempty"`
+	Misses               int64             `protobuf:"varint,4,opt,name=misses,proto3" json:"misses,omitempty"`
+	Evictions            int64             `protobuf:"varint,5,opt,name=evictions,proto3" json:"evictions,omitempty"`
+	Expirations          int64             `protobuf:"varint,6,opt,name=expirations,proto3" json:"expirations,omitempty"`
+	Bytes                uint64            `protobuf:"varint,7,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	Capacity             uint64            `protobuf:"varint,8,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Requests             map[string]uint64 `protobuf:"bytes,9,rep,name=requests,proto3" json:"requests,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Forwarded            map[string]uint64 `protobuf:"bytes,10,rep,name=forwarded,proto3" json:"forwarded,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Namespaces           []*NamespaceStats `protobuf:"bytes,11,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	Error                string            `protobuf:"bytes,12,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *NodeStats) Reset()         { *m = NodeStats{} }
+func (m *NodeStats) String() string { return proto.CompactTextString(m) }
+func (*NodeStats) ProtoMessage()    {}
+func (*NodeStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{31}
+}
+
+func (m *NodeStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeStats.Unmarshal(m, b)
+}
+func (m *NodeStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeStats.Marshal(b, m, deterministic)
+}
+func (m *NodeStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeStats.Merge(m, src)
+}
+func (m *NodeStats) XXX_Size() int {
+	return xxx_messageInfo_NodeStats.Size(m)
+}
+func (m *NodeStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeStats proto.InternalMessageInfo
+
+func (m *NodeStats) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *NodeStats) GetEntries() int64 {
+	if m != nil {
+		return m.Entries
+	}
+	return 0
+}
+
+func (m *NodeStats) GetHits() int64 {
+	if m != nil {
+		return m.Hits
+	}
+	return 0
+}
+
+func (m *NodeStats) GetMisses() int64 {
+	if m != nil {
+		return m.Misses
+	}
+	return 0
+}
+
+func (m *NodeStats) GetEvictions() int64 {
+	if m != nil {
+		return m.Evictions
+	}
+	return 0
+}
+
+func (m *NodeStats) GetExpirations() int64 {
+	if m != nil {
+		return m.Expirations
+	}
+	return 0
+}
+
+func (m *NodeStats) GetBytes() uint64 {
+	if m != nil {
+		return m.Bytes
+	}
+	return 0
+}
+
+func (m *NodeStats) GetCapacity() uint64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+func (m *NodeStats) GetRequests() map[string]uint64 {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
+}
+
+func (m *NodeStats) GetForwarded() map[string]uint64 {
+	if m != nil {
+		return m.Forwarded
+	}
+	return nil
+}
+
+func (m *NodeStats) GetNamespaces() []*NamespaceStats {
+	if m != nil {
+		return m.Namespaces
+	}
+	return nil
+}
+
+func (m *NodeStats) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type StatsReply struct {
+	Nodes                []*NodeStats `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Total                *NodeStats   `protobuf:"bytes,2,opt,name=total,proto3" json:"total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *StatsReply) Reset()         { *m = StatsReply{} }
+func (m *StatsReply) String() string { return proto.CompactTextString(m) }
+func (*StatsReply) ProtoMessage()    {}
+func (*StatsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{32}
+}
+
+func (m *StatsReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StatsReply.Unmarshal(m, b)
+}
+func (m *StatsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StatsReply.Marshal(b, m, deterministic)
+}
+func (m *StatsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatsReply.Merge(m, src)
+}
+func (m *StatsReply) XXX_Size() int {
+	return xxx_messageInfo_StatsReply.Size(m)
+}
+func (m *StatsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatsReply proto.InternalMessageInfo
+
+func (m *StatsReply) GetNodes() []*NodeStats {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *StatsReply) GetTotal() *NodeStats {
+	if m != nil {
+		return m.Total
+	}
+	return nil
+}
+
+type HashSetRequest struct {
+	Key                  string            `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Fields               map[string][]byte `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Expiration           uint32            `protobuf:"varint,3,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	Namespace            string            `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *HashSetRequest) Reset()         { *m = HashSetRequest{} }
+func (m *HashSetRequest) String() string { return proto.CompactTextString(m) }
+func (*HashSetRequest) ProtoMessage()    {}
+func (*HashSetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{33}
+}
+
+func (m *HashSetRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HashSetRequest.Unmarshal(m, b)
+}
+func (m *HashSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HashSetRequest.Marshal(b, m, deterministic)
+}
+func (m *HashSetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HashSetRequest.Merge(m, src)
+}
+func (m *HashSetRequest) XXX_Size() int {
+	return xxx_messageInfo_HashSetRequest.Size(m)
+}
+func (m *HashSetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HashSetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HashSetRequest proto.InternalMessageInfo
+
+func (m *HashSetRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *HashSetRequest) GetFields() map[string][]byte {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+func (m *HashSetRequest) GetExpiration() uint32 {
+	if m != nil {
+		return m.Expiration
+	}
+	return 0
+}
+
+func (m *HashSetRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type HashGetRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Fields               []string `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	Namespace            string   `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HashGetRequest) Reset()         { *m = HashGetRequest{} }
+func (m *HashGetRequest) String() string { return proto.CompactTextString(m) }
+func (*HashGetRequest) ProtoMessage()    {}
+func (*HashGetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{34}
+}
+
+func (m *HashGetRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HashGetRequest.Unmarshal(m, b)
+}
+func (m *HashGetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HashGetRequest.Marshal(b, m, deterministic)
+}
+func (m *HashGetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HashGetRequest.Merge(m, src)
+}
+func (m *HashGetRequest) XXX_Size() int {
+	return xxx_messageInfo_HashGetRequest.Size(m)
+}
+func (m *HashGetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HashGetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HashGetRequest proto.InternalMessageInfo
+
+func (m *HashGetRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *HashGetRequest) GetFields() []string {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+func (m *HashGetRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type HashIncrRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Field                string   `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Delta                int64    `protobuf:"varint,3,opt,name=delta,proto3" json:"delta,omitempty"`
+	Expiration           uint32   `protobuf:"varint,4,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	Namespace            string   `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HashIncrRequest) Reset()         { *m = HashIncrRequest{} }
+func (m *HashIncrRequest) String() string { return proto.CompactTextString(m) }
+func (*HashIncrRequest) ProtoMessage()    {}
+func (*HashIncrRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{35}
+}
+
+func (m *HashIncrRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HashIncrRequest.Unmarshal(m, b)
+}
+func (m *HashIncrRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HashIncrRequest.Marshal(b, m, deterministic)
+}
+func (m *HashIncrRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HashIncrRequest.Merge(m, src)
+}
+func (m *HashIncrRequest) XXX_Size() int {
+	return xxx_messageInfo_HashIncrRequest.Size(m)
+}
+func (m *HashIncrRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HashIncrRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HashIncrRequest proto.InternalMessageInfo
+
+func (m *HashIncrRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *HashIncrRequest) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *HashIncrRequest) GetDelta() int64 {
+	if m != nil {
+		return m.Delta
+	}
+	return 0
+}
+
+func (m *HashIncrRequest) GetExpiration() uint32 {
+	if m != nil {
+		return m.Expiration
+	}
+	return 0
+}
+
+func (m *HashIncrRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type HashReply struct {
+	Fields               map[string][]byte `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	LastUpdate           uint64            `protobuf:"varint,2,opt,name=lastUpdate,proto3" json:"lastUpdate,omitempty"`
+	Count                uint32            `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Value                int64             `protobuf:"varint,4,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *HashReply) Reset()         { *m = HashReply{} }
+func (m *HashReply) String() string { return proto.CompactTextString(m) }
+func (*HashReply) ProtoMessage()    {}
+func (*HashReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{36}
+}
+
+func (m *HashReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HashReply.Unmarshal(m, b)
+}
+func (m *HashReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HashReply.Marshal(b, m, deterministic)
+}
+func (m *HashReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HashReply.Merge(m, src)
+}
+func (m *HashReply) XXX_Size() int {
+	return xxx_messageInfo_HashReply.Size(m)
+}
+func (m *HashReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_HashReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HashReply proto.InternalMessageInfo
+
+func (m *HashReply) GetFields() map[string][]byte {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+func (m *HashReply) GetLastUpdate() uint64 {
+	if m != nil {
+		return m.LastUpdate
+	}
+	return 0
+}
+
+func (m *HashReply) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *HashReply) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+type ListPushRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Values               [][]byte `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	Expiration           uint32   `protobuf:"varint,3,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	Namespace            string   `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListPushRequest) Reset()         { *m = ListPushRequest{} }
+func (m *ListPushRequest) String() string { return proto.CompactTextString(m) }
+func (*ListPushRequest) ProtoMessage()    {}
+func (*ListPushRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{37}
+}
+
+func (m *ListPushRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPushRequest.Unmarshal(m, b)
+}
+func (m *ListPushRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPushRequest.Marshal(b, m, deterministic)
+}
+func (m *ListPushRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPushRequest.Merge(m, src)
+}
+func (m *ListPushRequest) XXX_Size() int {
+	return xxx_messageInfo_ListPushRequest.Size(m)
+}
+func (m *ListPushRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPushRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPushRequest proto.InternalMessageInfo
+
+func (m *ListPushRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ListPushRequest) GetValues() [][]byte {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+func (m *ListPushRequest) GetExpiration() uint32 {
+	if m != nil {
+		return m.Expiration
+	}
+	return 0
+}
+
+func (m *ListPushRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type ListPopRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Count                uint32   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Timeout              uint32   `protobuf:"varint,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	Namespace            string   `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListPopRequest) Reset()         { *m = ListPopRequest{} }
+func (m *ListPopRequest) String() string { return proto.CompactTextString(m) }
+func (*ListPopRequest) ProtoMessage()    {}
+func (*ListPopRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{38}
+}
+
+func (m *ListPopRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPopRequest.Unmarshal(m, b)
+}
+func (m *ListPopRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPopRequest.Marshal(b, m, deterministic)
+}
+func (m *ListPopRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPopRequest.Merge(m, src)
+}
+func (m *ListPopRequest) XXX_Size() int {
+	return xxx_messageInfo_ListPopRequest.Size(m)
+}
+func (m *ListPopRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPopRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPopRequest proto.InternalMessageInfo
+
+func (m *ListPopRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ListPopRequest) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ListPopRequest) GetTimeout() uint32 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+func (m *ListPopRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type ListRangeRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Start                int64    `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	Stop                 int64    `protobuf:"varint,3,opt,name=stop,proto3" json:"stop,omitempty"`
+	Namespace            string   `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListRangeRequest) Reset()         { *m = ListRangeRequest{} }
+func (m *ListRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*ListRangeRequest) ProtoMessage()    {}
+func (*ListRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{39}
+}
+
+func (m *ListRangeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRangeRequest.Unmarshal(m, b)
+}
+func (m *ListRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRangeRequest.Marshal(b, m, deterministic)
+}
+func (m *ListRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRangeRequest.Merge(m, src)
+}
+func (m *ListRangeRequest) XXX_Size() int {
+	return xxx_messageInfo_ListRangeRequest.Size(m)
+}
+func (m *ListRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRangeRequest proto.InternalMessageInfo
+
+func (m *ListRangeRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ListRangeRequest) GetStart() int64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *ListRangeRequest) GetStop() int64 {
+	if m != nil {
+		return m.Stop
+	}
+	return 0
+}
+
+func (m *ListRangeRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type ListReply struct {
+	Values               [][]byte `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	Length               uint64   `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
+	LastUpdate           uint64   `protobuf:"varint,3,opt,name=lastUpdate,proto3" json:"lastUpdate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListReply) Reset()         { *m = ListReply{} }
+func (m *ListReply) String() string { return proto.CompactTextString(m) }
+func (*ListReply) ProtoMessage()    {}
+func (*ListReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{40}
+}
+
+func (m *ListReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListReply.Unmarshal(m, b)
+}
+func (m *ListReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListReply.Marshal(b, m, deterministic)
+}
+func (m *ListReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListReply.Merge(m, src)
+}
+func (m *ListReply) XXX_Size() int {
+	return xxx_messageInfo_ListReply.Size(m)
+}
+func (m *ListReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListReply proto.InternalMessageInfo
+
+func (m *ListReply) GetValues() [][]byte {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+func (m *ListReply) GetLength() uint64 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
+func (m *ListReply) GetLastUpdate() uint64 {
+	if m != nil {
+		return m.LastUpdate
+	}
+	return 0
+}
+
+type SortedSetMember struct {
+	Member               string   `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	Score                float64  `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SortedSetMember) Reset()         { *m = SortedSetMember{} }
+func (m *SortedSetMember) String() string { return proto.CompactTextString(m) }
+func (*SortedSetMember) ProtoMessage()    {}
+func (*SortedSetMember) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{41}
+}
+
+func (m *SortedSetMember) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SortedSetMember.Unmarshal(m, b)
+}
+func (m *SortedSetMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SortedSetMember.Marshal(b, m, deterministic)
+}
+func (m *SortedSetMember) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SortedSetMember.Merge(m, src)
+}
+func (m *SortedSetMember) XXX_Size() int {
+	return xxx_messageInfo_SortedSetMember.Size(m)
+}
+func (m *SortedSetMember) XXX_DiscardUnknown() {
+	xxx_messageInfo_SortedSetMember.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SortedSetMember proto.InternalMessageInfo
+
+func (m *SortedSetMember) GetMember() string {
+	if m != nil {
+		return m.Member
+	}
+	return ""
+}
+
+func (m *SortedSetMember) GetScore() float64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+type SortedSetAddRequest struct {
+	Key                  string             `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Members              []*SortedSetMember `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	Expiration           uint32             `protobuf:"varint,3,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	Namespace            string             `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *SortedSetAddRequest) Reset()         { *m = SortedSetAddRequest{} }
+func (m *SortedSetAddRequest) String() string { return proto.CompactTextString(m) }
+func (*SortedSetAddRequest) ProtoMessage()    {}
+func (*SortedSetAddRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{42}
+}
+
+func (m *SortedSetAddRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SortedSetAddRequest.Unmarshal(m, b)
+}
+func (m *SortedSetAddRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SortedSetAddRequest.Marshal(b, m, deterministic)
+}
+func (m *SortedSetAddRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SortedSetAddRequest.Merge(m, src)
+}
+func (m *SortedSetAddRequest) XXX_Size() int {
+	return xxx_messageInfo_SortedSetAddRequest.Size(m)
+}
+func (m *SortedSetAddRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SortedSetAddRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SortedSetAddRequest proto.InternalMessageInfo
+
+func (m *SortedSetAddRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *SortedSetAddRequest) GetMembers() []*SortedSetMember {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+func (m *SortedSetAddRequest) GetExpiration() uint32 {
+	if m != nil {
+		return m.Expiration
+	}
+	return 0
+}
+
+func (m *SortedSetAddRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type SortedSetIncrRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Member               string   `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+	Delta                float64  `protobuf:"fixed64,3,opt,name=delta,proto3" json:"delta,omitempty"`
+	Expiration           uint32   `protobuf:"varint,4,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	Namespace            string   `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SortedSetIncrRequest) Reset()         { *m = SortedSetIncrRequest{} }
+func (m *SortedSetIncrRequest) String() string { return proto.CompactTextString(m) }
+func (*SortedSetIncrRequest) ProtoMessage()    {}
+func (*SortedSetIncrRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{43}
+}
+
+func (m *SortedSetIncrRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SortedSetIncrRequest.Unmarshal(m, b)
+}
+func (m *SortedSetIncrRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SortedSetIncrRequest.Marshal(b, m, deterministic)
+}
+func (m *SortedSetIncrRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SortedSetIncrRequest.Merge(m, src)
+}
+func (m *SortedSetIncrRequest) XXX_Size() int {
+	return xxx_messageInfo_SortedSetIncrRequest.Size(m)
+}
+func (m *SortedSetIncrRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SortedSetIncrRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SortedSetIncrRequest proto.InternalMessageInfo
+
+func (m *SortedSetIncrRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *SortedSetIncrRequest) GetMember() string {
+	if m != nil {
+		return m.Member
+	}
+	return ""
+}
+
+func (m *SortedSetIncrRequest) GetDelta() float64 {
+	if m != nil {
+		return m.Delta
+	}
+	return 0
+}
+
+func (m *SortedSetIncrRequest) GetExpiration() uint32 {
+	if m != nil {
+		return m.Expiration
+	}
+	return 0
+}
+
+func (m *SortedSetIncrRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type SortedSetRangeRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Start                int64    `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	Stop                 int64    `protobuf:"varint,3,opt,name=stop,proto3" json:"stop,omitempty"`
+	Reverse              bool     `protobuf:"varint,4,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	Namespace            string   `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SortedSetRangeRequest) Reset()         { *m = SortedSetRangeRequest{} }
+func (m *SortedSetRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*SortedSetRangeRequest) ProtoMessage()    {}
+func (*SortedSetRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{44}
+}
+
+func (m *SortedSetRangeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SortedSetRangeRequest.Unmarshal(m, b)
+}
+func (m *SortedSetRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SortedSetRangeRequest.Marshal(b, m, deterministic)
+}
+func (m *SortedSetRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SortedSetRangeRequest.Merge(m, src)
+}
+func (m *SortedSetRangeRequest) XXX_Size() int {
+	return xxx_messageInfo_SortedSetRangeRequest.Size(m)
+}
+func (m *SortedSetRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SortedSetRangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SortedSetRangeRequest proto.InternalMessageInfo
+
+func (m *SortedSetRangeRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *SortedSetRangeRequest) GetStart() int64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *SortedSetRangeRequest) GetStop() int64 {
+	if m != nil {
+		return m.Stop
+	}
+	return 0
+}
+
+func (m *SortedSetRangeRequest) GetReverse() bool {
+	if m != nil {
+		return m.Reverse
+	}
+	return false
+}
+
+func (m *SortedSetRangeRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type SortedSetScoreRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Min                  float64  `protobuf:"fixed64,2,opt,name=min,proto3" json:"min,omitempty"`
+	Max                  float64  `protobuf:"fixed64,3,opt,name=max,proto3" json:"max,omitempty"`
+	Reverse              bool     `protobuf:"varint,4,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	Limit                uint32   `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	Namespace            string   `protobuf:"bytes,6,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SortedSetScoreRequest) Reset()         { *m = SortedSetScoreRequest{} }
+func (m *SortedSetScoreRequest) String() string { return proto.CompactTextString(m) }
+func (*SortedSetScoreRequest) ProtoMessage()    {}
+func (*SortedSetScoreRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{45}
+}
+
+func (m *SortedSetScoreRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SortedSetScoreRequest.Unmarshal(m, b)
+}
+func (m *SortedSetScoreRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SortedSetScoreRequest.Marshal(b, m, deterministic)
+}
+func (m *SortedSetScoreRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SortedSetScoreRequest.Merge(m, src)
+}
+func (m *SortedSetScoreRequest) XXX_Size() int {
+	return xxx_messageInfo_SortedSetScoreRequest.Size(m)
+}
+func (m *SortedSetScoreRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SortedSetScoreRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SortedSetScoreRequest proto.InternalMessageInfo
+
+func (m *SortedSetScoreRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *SortedSetScoreRequest) GetMin() float64 {
+	if m != nil {
+		return m.Min
+	}
+	return 0
+}
+
+func (m *SortedSetScoreRequest) GetMax() float64 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
+func (m *SortedSetScoreRequest) GetReverse() bool {
+	if m != nil {
+		return m.Reverse
+	}
+	return false
+}
+
+func (m *SortedSetScoreRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *SortedSetScoreRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type SortedSetReply struct {
+	Members              []*SortedSetMember `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	LastUpdate           uint64             `protobuf:"varint,2,opt,name=lastUpdate,proto3" json:"lastUpdate,omitempty"`
+	Count                uint32             `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Score                float64            `protobuf:"fixed64,4,opt,name=score,proto3" json:"score,omitempty"`
+	Length               uint64             `protobuf:"varint,5,opt,name=length,proto3" json:"length,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *SortedSetReply) Reset()         { *m = SortedSetReply{} }
+func (m *SortedSetReply) String() string { return proto.CompactTextString(m) }
+func (*SortedSetReply) ProtoMessage()    {}
+func (*SortedSetReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{46}
+}
+
+func (m *SortedSetReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SortedSetReply.Unmarshal(m, b)
+}
+func (m *SortedSetReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SortedSetReply.Marshal(b, m, deterministic)
+}
+func (m *SortedSetReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SortedSetReply.Merge(m, src)
+}
+func (m *SortedSetReply) XXX_Size() int {
+	return xxx_messageInfo_SortedSetReply.Size(m)
+}
+func (m *SortedSetReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_SortedSetReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SortedSetReply proto.InternalMessageInfo
+
+func (m *SortedSetReply) GetMembers() []*SortedSetMember {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+func (m *SortedSetReply) GetLastUpdate() uint64 {
+	if m != nil {
+		return m.LastUpdate
+	}
+	return 0
+}
+
+func (m *SortedSetReply) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *SortedSetReply) GetScore() float64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *SortedSetReply) GetLength() uint64 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
+type PublishRequest struct {
+	Channel              string   `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Payload              []byte   `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PublishRequest) Reset()         { *m = PublishRequest{} }
+func (m *PublishRequest) String() string { return proto.CompactTextString(m) }
+func (*PublishRequest) ProtoMessage()    {}
+func (*PublishRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{47}
+}
+
+func (m *PublishRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PublishRequest.Unmarshal(m, b)
+}
+func (m *PublishRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PublishRequest.Marshal(b, m, deterministic)
+}
+func (m *PublishRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PublishRequest.Merge(m, src)
+}
+func (m *PublishRequest) XXX_Size() int {
+	return xxx_messageInfo_PublishRequest.Size(m)
+}
+func (m *PublishRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PublishRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PublishRequest proto.InternalMessageInfo
+
+func (m *PublishRequest) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *PublishRequest) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+type PublishReply struct {
+	Receivers            uint32   `protobuf:"varint,1,opt,name=receivers,proto3" json:"receivers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PublishReply) Reset()         { *m = PublishReply{} }
+func (m *PublishReply) String() string { return proto.CompactTextString(m) }
+func (*PublishReply) ProtoMessage()    {}
+func (*PublishReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{48}
+}
+
+func (m *PublishReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PublishReply.Unmarshal(m, b)
+}
+func (m *PublishReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PublishReply.Marshal(b, m, deterministic)
+}
+func (m *PublishReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PublishReply.Merge(m, src)
+}
+func (m *PublishReply) XXX_Size() int {
+	return xxx_messageInfo_PublishReply.Size(m)
+}
+func (m *PublishReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_PublishReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PublishReply proto.InternalMessageInfo
+
+func (m *PublishReply) GetReceivers() uint32 {
+	if m != nil {
+		return m.Receivers
+	}
+	return 0
+}
+
+type SubscribeRequest struct {
+	Channels             []string `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	Patterns             []string `protobuf:"bytes,2,rep,name=patterns,proto3" json:"patterns,omitempty"`
+	Local                bool     `protobuf:"varint,3,opt,name=local,proto3" json:"local,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubscribeRequest) Reset()         { *m = SubscribeRequest{} }
+func (m *SubscribeRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeRequest) ProtoMessage()    {}
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{49}
+}
+
+func (m *SubscribeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeRequest.Unmarshal(m, b)
+}
+func (m *SubscribeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeRequest.Marshal(b, m, deterministic)
+}
+func (m *SubscribeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeRequest.Merge(m, src)
+}
+func (m *SubscribeRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscribeRequest.Size(m)
+}
+func (m *SubscribeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeRequest proto.InternalMessageInfo
+
+func (m *SubscribeRequest) GetChannels() []string {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+func (m *SubscribeRequest) GetPatterns() []string {
+	if m != nil {
+		return m.Patterns
+	}
+	return nil
+}
+
+func (m *SubscribeRequest) GetLocal() bool {
+	if m != nil {
+		return m.Local
+	}
+	return false
+}
+
+type Message struct {
+	Channel              string   `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Pattern              string   `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Payload              []byte   `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Message) Reset()         { *m = Message{} }
+func (m *Message) String() string { return proto.CompactTextString(m) }
+func (*Message) ProtoMessage()    {}
+func (*Message) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{50}
+}
+
+func (m *Message) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Message.Unmarshal(m, b)
+}
+func (m *Message) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Message.Marshal(b, m, deterministic)
+}
+func (m *Message) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Message.Merge(m, src)
+}
+func (m *Message) XXX_Size() int {
+	return xxx_messageInfo_Message.Size(m)
+}
+func (m *Message) XXX_DiscardUnknown() {
+	xxx_messageInfo_Message.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Message proto.InternalMessageInfo
+
+func (m *Message) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *Message) GetPattern() string {
+	if m != nil {
+		return m.Pattern
+	}
+	return ""
+}
+
+func (m *Message) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+type LockRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Ttl                  uint32   `protobuf:"varint,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	Token                uint64   `protobuf:"varint,3,opt,name=token,proto3" json:"token,omitempty"`
+	Holder               string   `protobuf:"bytes,4,opt,name=holder,proto3" json:"holder,omitempty"`
+	Namespace            string   `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LockRequest) Reset()         { *m = LockRequest{} }
+func (m *LockRequest) String() string { return proto.CompactTextString(m) }
+func (*LockRequest) ProtoMessage()    {}
+func (*LockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{51}
+}
+
+func (m *LockRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LockRequest.Unmarshal(m, b)
+}
+func (m *LockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LockRequest.Marshal(b, m, deterministic)
+}
+func (m *LockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockRequest.Merge(m, src)
+}
+func (m *LockRequest) XXX_Size() int {
+	return xxx_messageInfo_LockRequest.Size(m)
+}
+func (m *LockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockRequest proto.InternalMessageInfo
+
+func (m *LockRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *LockRequest) GetTtl() uint32 {
+	if m != nil {
+		return m.Ttl
+	}
+	return 0
+}
+
+func (m *LockRequest) GetToken() uint64 {
+	if m != nil {
+		return m.Token
+	}
+	return 0
+}
+
+func (m *LockRequest) GetHolder() string {
+	if m != nil {
+		return m.Holder
+	}
+	return ""
+}
+
+func (m *LockRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type LockReply struct {
+	Token                uint64   `protobuf:"varint,1,opt,name=token,proto3" json:"token,omitempty"`
+	Holder               string   `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LockReply) Reset()         { *m = LockReply{} }
+func (m *LockReply) String() string { return proto.CompactTextString(m) }
+func (*LockReply) ProtoMessage()    {}
+func (*LockReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{52}
+}
+
+func (m *LockReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LockReply.Unmarshal(m, b)
+}
+func (m *LockReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LockReply.Marshal(b, m, deterministic)
+}
+func (m *LockReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockReply.Merge(m, src)
+}
+func (m *LockReply) XXX_Size() int {
+	return xxx_messageInfo_LockReply.Size(m)
+}
+func (m *LockReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockReply proto.InternalMessageInfo
+
+func (m *LockReply) GetToken() uint64 {
+	if m != nil {
+		return m.Token
+	}
+	return 0
+}
+
+func (m *LockReply) GetHolder() string {
+	if m != nil {
+		return m.Holder
+	}
+	return ""
+}
+
+type RateLimitRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Capacity             float64  `protobuf:"fixed64,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Rate                 float64  `protobuf:"fixed64,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	Cost                 float64  `protobuf:"fixed64,4,opt,name=cost,proto3" json:"cost,omitempty"`
+	Namespace            string   `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RateLimitRequest) Reset()         { *m = RateLimitRequest{} }
+func (m *RateLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*RateLimitRequest) ProtoMessage()    {}
+func (*RateLimitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{53}
+}
+
+func (m *RateLimitRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RateLimitRequest.Unmarshal(m, b)
+}
+func (m *RateLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RateLimitRequest.Marshal(b, m, deterministic)
+}
+func (m *RateLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitRequest.Merge(m, src)
+}
+func (m *RateLimitRequest) XXX_Size() int {
+	return xxx_messageInfo_RateLimitRequest.Size(m)
+}
+func (m *RateLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitRequest proto.InternalMessageInfo
+
+func (m *RateLimitRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *RateLimitRequest) GetCapacity() float64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+func (m *RateLimitRequest) GetRate() float64 {
+	if m != nil {
+		return m.Rate
+	}
+	return 0
+}
+
+func (m *RateLimitRequest) GetCost() float64 {
+	if m != nil {
+		return m.Cost
+	}
+	return 0
+}
+
+func (m *RateLimitRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type RateLimitReply struct {
+	Allowed              bool     `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	Remaining            float64  `protobuf:"fixed64,2,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	RetryAfter           uint32   `protobuf:"varint,3,opt,name=retryAfter,proto3" json:"retryAfter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RateLimitReply) Reset()         { *m = RateLimitReply{} }
+func (m *RateLimitReply) String() string { return proto.CompactTextString(m) }
+func (*RateLimitReply) ProtoMessage()    {}
+func (*RateLimitReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{54}
+}
+
+func (m *RateLimitReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RateLimitReply.Unmarshal(m, b)
+}
+func (m *RateLimitReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RateLimitReply.Marshal(b, m, deterministic)
+}
+func (m *RateLimitReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitReply.Merge(m, src)
+}
+func (m *RateLimitReply) XXX_Size() int {
+	return xxx_messageInfo_RateLimitReply.Size(m)
+}
+func (m *RateLimitReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitReply proto.InternalMessageInfo
+
+func (m *RateLimitReply) GetAllowed() bool {
+	if m != nil {
+		return m.Allowed
+	}
+	return false
+}
+
+func (m *RateLimitReply) GetRemaining() float64 {
+	if m != nil {
+		return m.Remaining
+	}
+	return 0
+}
+
+func (m *RateLimitReply) GetRetryAfter() uint32 {
+	if m != nil {
+		return m.RetryAfter
+	}
+	return 0
+}
+
+type HyperLogLogAddRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Elements             [][]byte `protobuf:"bytes,2,rep,name=elements,proto3" json:"elements,omitempty"`
+	Expiration           uint32   `protobuf:"varint,3,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	Namespace            string   `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HyperLogLogAddRequest) Reset()         { *m = HyperLogLogAddRequest{} }
+func (m *HyperLogLogAddRequest) String() string { return proto.CompactTextString(m) }
+func (*HyperLogLogAddRequest) ProtoMessage()    {}
+func (*HyperLogLogAddRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{55}
+}
+
+func (m *HyperLogLogAddRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HyperLogLogAddRequest.Unmarshal(m, b)
+}
+func (m *HyperLogLogAddRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HyperLogLogAddRequest.Marshal(b, m, deterministic)
+}
+func (m *HyperLogLogAddRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HyperLogLogAddRequest.Merge(m, src)
+}
+func (m *HyperLogLogAddRequest) XXX_Size() int {
+	return xxx_messageInfo_HyperLogLogAddRequest.Size(m)
+}
+func (m *HyperLogLogAddRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HyperLogLogAddRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HyperLogLogAddRequest proto.InternalMessageInfo
+
+func (m *HyperLogLogAddRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *HyperLogLogAddRequest) GetElements() [][]byte {
+	if m != nil {
+		return m.Elements
+	}
+	return nil
+}
+
+func (m *HyperLogLogAddRequest) GetExpiration() uint32 {
+	if m != nil {
+		return m.Expiration
+	}
+	return 0
+}
+
+func (m *HyperLogLogAddRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type HyperLogLogCountRequest struct {
+	Keys                 []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HyperLogLogCountRequest) Reset()         { *m = HyperLogLogCountRequest{} }
+func (m *HyperLogLogCountRequest) String() string { return proto.CompactTextString(m) }
+func (*HyperLogLogCountRequest) ProtoMessage()    {}
+func (*HyperLogLogCountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{56}
+}
+
+func (m *HyperLogLogCountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HyperLogLogCountRequest.Unmarshal(m, b)
+}
+func (m *HyperLogLogCountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HyperLogLogCountRequest.Marshal(b, m, deterministic)
+}
+func (m *HyperLogLogCountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HyperLogLogCountRequest.Merge(m, src)
+}
+func (m *HyperLogLogCountRequest) XXX_Size() int {
+	return xxx_messageInfo_HyperLogLogCountRequest.Size(m)
+}
+func (m *HyperLogLogCountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HyperLogLogCountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HyperLogLogCountRequest proto.InternalMessageInfo
+
+func (m *HyperLogLogCountRequest) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *HyperLogLogCountRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type HyperLogLogMergeRequest struct {
+	Destination          string   `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	Sources              []string `protobuf:"bytes,2,rep,name=sources,proto3" json:"sources,omitempty"`
+	Expiration           uint32   `protobuf:"varint,3,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	Namespace            string   `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HyperLogLogMergeRequest) Reset()         { *m = HyperLogLogMergeRequest{} }
+func (m *HyperLogLogMergeRequest) String() string { return proto.CompactTextString(m) }
+func (*HyperLogLogMergeRequest) ProtoMessage()    {}
+func (*HyperLogLogMergeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{57}
+}
+
+func (m *HyperLogLogMergeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HyperLogLogMergeRequest.Unmarshal(m, b)
+}
+func (m *HyperLogLogMergeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HyperLogLogMergeRequest.Marshal(b, m, deterministic)
+}
+func (m *HyperLogLogMergeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HyperLogLogMergeRequest.Merge(m, src)
+}
+func (m *HyperLogLogMergeRequest) XXX_Size() int {
+	return xxx_messageInfo_HyperLogLogMergeRequest.Size(m)
+}
+func (m *HyperLogLogMergeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HyperLogLogMergeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HyperLogLogMergeRequest proto.InternalMessageInfo
+
+func (m *HyperLogLogMergeRequest) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+func (m *HyperLogLogMergeRequest) GetSources() []string {
+	if m != nil {
+		return m.Sources
+	}
+	return nil
+}
+
+func (m *HyperLogLogMergeRequest) GetExpiration() uint32 {
+	if m != nil {
+		return m.Expiration
+	}
+	return 0
+}
+
+func (m *HyperLogLogMergeRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type HyperLogLogReply struct {
+	Changed              bool     `protobuf:"varint,1,opt,name=changed,proto3" json:"changed,omitempty"`
+	Count                uint64   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HyperLogLogReply) Reset()         { *m = HyperLogLogReply{} }
+func (m *HyperLogLogReply) String() string { return proto.CompactTextString(m) }
+func (*HyperLogLogReply) ProtoMessage()    {}
+func (*HyperLogLogReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{58}
+}
+
+func (m *HyperLogLogReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HyperLogLogReply.Unmarshal(m, b)
+}
+func (m *HyperLogLogReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HyperLogLogReply.Marshal(b, m, deterministic)
+}
+func (m *HyperLogLogReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HyperLogLogReply.Merge(m, src)
+}
+func (m *HyperLogLogReply) XXX_Size() int {
+	return xxx_messageInfo_HyperLogLogReply.Size(m)
+}
+func (m *HyperLogLogReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_HyperLogLogReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HyperLogLogReply proto.InternalMessageInfo
+
+func (m *HyperLogLogReply) GetChanged() bool {
+	if m != nil {
+		return m.Changed
+	}
+	return false
+}
+
+func (m *HyperLogLogReply) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type BloomCreateRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Capacity             uint64   `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	ErrorRate            float64  `protobuf:"fixed64,3,opt,name=errorRate,proto3" json:"errorRate,omitempty"`
+	Expiration           uint32   `protobuf:"varint,4,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	Namespace            string   `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BloomCreateRequest) Reset()         { *m = BloomCreateRequest{} }
+func (m *BloomCreateRequest) String() string { return proto.CompactTextString(m) }
+func (*BloomCreateRequest) ProtoMessage()    {}
+func (*BloomCreateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{59}
+}
+
+func (m *BloomCreateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BloomCreateRequest.Unmarshal(m, b)
+}
+func (m *BloomCreateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BloomCreateRequest.Marshal(b, m, deterministic)
+}
+func (m *BloomCreateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BloomCreateRequest.Merge(m, src)
+}
+func (m *BloomCreateRequest) XXX_Size() int {
+	return xxx_messageInfo_BloomCreateRequest.Size(m)
+}
+func (m *BloomCreateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BloomCreateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BloomCreateRequest proto.InternalMessageInfo
+
+func (m *BloomCreateRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *BloomCreateRequest) GetCapacity() uint64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+func (m *BloomCreateRequest) GetErrorRate() float64 {
+	if m != nil {
+		return m.ErrorRate
+	}
+	return 0
+}
+
+func (m *BloomCreateRequest) GetExpiration() uint32 {
+	if m != nil {
+		return m.Expiration
+	}
+	return 0
+}
+
+func (m *BloomCreateRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type BloomRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Elements             [][]byte `protobuf:"bytes,2,rep,name=elements,proto3" json:"elements,omitempty"`
+	Namespace            string   `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BloomRequest) Reset()         { *m = BloomRequest{} }
+func (m *BloomRequest) String() string { return proto.CompactTextString(m) }
+func (*BloomRequest) ProtoMessage()    {}
+func (*BloomRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{60}
+}
+
+func (m *BloomRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BloomRequest.Unmarshal(m, b)
+}
+func (m *BloomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BloomRequest.Marshal(b, m, deterministic)
+}
+func (m *BloomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BloomRequest.Merge(m, src)
+}
+func (m *BloomRequest) XXX_Size() int {
+	return xxx_messageInfo_BloomRequest.Size(m)
+}
+func (m *BloomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BloomRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BloomRequest proto.InternalMessageInfo
+
+func (m *BloomRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *BloomRequest) GetElements() [][]byte {
+	if m != nil {
+		return m.Elements
+	}
+	return nil
+}
+
+func (m *BloomRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type BloomReply struct {
+	Results              []bool   `protobuf:"varint,1,rep,packed,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BloomReply) Reset()         { *m = BloomReply{} }
+func (m *BloomReply) String() string { return proto.CompactTextString(m) }
+func (*BloomReply) ProtoMessage()    {}
+func (*BloomReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{61}
+}
+
+func (m *BloomReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BloomReply.Unmarshal(m, b)
+}
+func (m *BloomReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BloomReply.Marshal(b, m, deterministic)
+}
+func (m *BloomReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BloomReply.Merge(m, src)
+}
+func (m *BloomReply) XXX_Size() int {
+	return xxx_messageInfo_BloomReply.Size(m)
+}
+func (m *BloomReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_BloomReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BloomReply proto.InternalMessageInfo
+
+func (m *BloomReply) GetResults() []bool {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type MemberUpdate struct {
+	Address              string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	State                MemberUpdate_State `protobuf:"varint,2,opt,name=state,proto3,enum=definitions.MemberUpdate_State" json:"state,omitempty"`
+	Incarnation          uint64             `protobuf:"varint,3,opt,name=incarnation,proto3" json:"incarnation,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *MemberUpdate) Reset()         { *m = MemberUpdate{} }
+func (m *MemberUpdate) String() string { return proto.CompactTextString(m) }
+func (*MemberUpdate) ProtoMessage()    {}
+func (*MemberUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{62}
+}
+
+func (m *MemberUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MemberUpdate.Unmarshal(m, b)
+}
+func (m *MemberUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MemberUpdate.Marshal(b, m, deterministic)
+}
+func (m *MemberUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MemberUpdate.Merge(m, src)
+}
+func (m *MemberUpdate) XXX_Size() int {
+	return xxx_messageInfo_MemberUpdate.Size(m)
+}
+func (m *MemberUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MemberUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MemberUpdate proto.InternalMessageInfo
+
+func (m *MemberUpdate) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MemberUpdate) GetState() MemberUpdate_State {
+	if m != nil {
+		return m.State
+	}
+	return MemberUpdate_ALIVE
+}
+
+func (m *MemberUpdate) GetIncarnation() uint64 {
+	if m != nil {
+		return m.Incarnation
+	}
+	return 0
+}
+
+type PingRequest struct {
+	Updates              []*MemberUpdate `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates,omitempty"`
+	Sync                 bool            `protobuf:"varint,2,opt,name=sync,proto3" json:"sync,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *PingRequest) Reset()         { *m = PingRequest{} }
+func (m *PingRequest) String() string { return proto.CompactTextString(m) }
+func (*PingRequest) ProtoMessage()    {}
+func (*PingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{63}
+}
+
+func (m *PingRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PingRequest.Unmarshal(m, b)
+}
+func (m *PingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PingRequest.Marshal(b, m, deterministic)
+}
+func (m *PingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PingRequest.Merge(m, src)
+}
+func (m *PingRequest) XXX_Size() int {
+	return xxx_messageInfo_PingRequest.Size(m)
+}
+func (m *PingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PingRequest proto.InternalMessageInfo
+
+func (m *PingRequest) GetUpdates() []*MemberUpdate {
+	if m != nil {
+		return m.Updates
+	}
+	return nil
+}
+
+func (m *PingRequest) GetSync() bool {
+	if m != nil {
+		return m.Sync
+	}
+	return false
+}
+
+type PingReqRequest struct {
+	Target               string          `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	Updates              []*MemberUpdate `protobuf:"bytes,2,rep,name=updates,proto3" json:"updates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *PingReqRequest) Reset()         { *m = PingReqRequest{} }
+func (m *PingReqRequest) String() string { return proto.CompactTextString(m) }
+func (*PingReqRequest) ProtoMessage()    {}
+func (*PingReqRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{64}
+}
+
+func (m *PingReqRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PingReqRequest.Unmarshal(m, b)
+}
+func (m *PingReqRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PingReqRequest.Marshal(b, m, deterministic)
+}
+func (m *PingReqRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PingReqRequest.Merge(m, src)
+}
+func (m *PingReqRequest) XXX_Size() int {
+	return xxx_messageInfo_PingReqRequest.Size(m)
+}
+func (m *PingReqRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PingReqRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PingReqRequest proto.InternalMessageInfo
+
+func (m *PingReqRequest) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *PingReqRequest) GetUpdates() []*MemberUpdate {
+	if m != nil {
+		return m.Updates
+	}
+	return nil
+}
+
+type PingReply struct {
+	Updates              []*MemberUpdate `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates,omitempty"`
+	Ack                  bool            `protobuf:"varint,2,opt,name=ack,proto3" json:"ack,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *PingReply) Reset()         { *m = PingReply{} }
+func (m *PingReply) String() string { return proto.CompactTextString(m) }
+func (*PingReply) ProtoMessage()    {}
+func (*PingReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{65}
+}
+
+func (m *PingReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PingReply.Unmarshal(m, b)
+}
+func (m *PingReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PingReply.Marshal(b, m, deterministic)
+}
+func (m *PingReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PingReply.Merge(m, src)
+}
+func (m *PingReply) XXX_Size() int {
+	return xxx_messageInfo_PingReply.Size(m)
+}
+func (m *PingReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_PingReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PingReply proto.InternalMessageInfo
+
+func (m *PingReply) GetUpdates() []*MemberUpdate {
+	if m != nil {
+		return m.Updates
+	}
+	return nil
+}
+
+func (m *PingReply) GetAck() bool {
+	if m != nil {
+		return m.Ack
+	}
+	return false
+}
+
+// Attached to the status of every error a server returns, so that clients can tell apart errors sharing a code.
+type ErrorReason struct {
+	Reason               string   `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ErrorReason) Reset()         { *m = ErrorReason{} }
+func (m *ErrorReason) String() string { return proto.CompactTextString(m) }
+func (*ErrorReason) ProtoMessage()    {}
+func (*ErrorReason) Descriptor() ([]byte, []int) {
+	return fileDescriptor_671b7d4d1004a799, []int{66}
+}
+
+func (m *ErrorReason) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ErrorReason.Unmarshal(m, b)
+}
+func (m *ErrorReason) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ErrorReason.Marshal(b, m, deterministic)
+}
+func (m *ErrorReason) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ErrorReason.Merge(m, src)
+}
+func (m *ErrorReason) XXX_Size() int {
+	return xxx_messageInfo_ErrorReason.Size(m)
+}
+func (m *ErrorReason) XXX_DiscardUnknown() {
+	xxx_messageInfo_ErrorReason.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ErrorReason proto.InternalMessageInfo
+
+func (m *ErrorReason) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("definitions.Kind", Kind_name, Kind_value)
+	proto.RegisterEnum("definitions.WatchEvent_Type", WatchEvent_Type_name, WatchEvent_Type_value)
+	proto.RegisterEnum("definitions.MemberUpdate_State", MemberUpdate_State_name, MemberUpdate_State_value)
+	proto.RegisterType((*Item)(nil), "definitions.Item")
+	proto.RegisterType((*AddRequest)(nil), "definitions.AddRequest")
+	proto.RegisterType((*CompareAndSwapRequest)(nil), "definitions.CompareAndSwapRequest")
+	proto.RegisterType((*SetRequest)(nil), "definitions.SetRequest")
+	proto.RegisterType((*DeleteRequest)(nil), "definitions.DeleteRequest")
+	proto.RegisterType((*DeleteAllRequest)(nil), "definitions.DeleteAllRequest")
+	proto.RegisterType((*GetRequest)(nil), "definitions.GetRequest")
+	proto.RegisterType((*Reply)(nil), "definitions.Reply")
+	proto.RegisterType((*AddServerRequest)(nil), "definitions.AddServerRequest")
+	proto.RegisterType((*HandOffRequest)(nil), "definitions.HandOffRequest")
+	proto.RegisterType((*DropServerRequest)(nil), "definitions.DropServerRequest")
+	proto.RegisterType((*GetServersRequest)(nil), "definitions.GetServersRequest")
+	proto.RegisterType((*ServerList)(nil), "definitions.ServerList")
+	proto.RegisterMapType((map[string]uint64)(nil), "definitions.ServerList.NamespacesEntry")
+	proto.RegisterType((*MultiGetRequest)(nil), "definitions.MultiGetRequest")
+	proto.RegisterType((*MultiSetRequest)(nil), "definitions.MultiSetRequest")
+	proto.RegisterType((*MultiDeleteRequest)(nil), "definitions.MultiDeleteRequest")
+	proto.RegisterType((*KeyResult)(nil), "definitions.KeyResult")
+	proto.RegisterType((*MultiReply)(nil), "definitions.MultiReply")
+	proto.RegisterType((*WatchRequest)(nil), "definitions.WatchRequest")
+	proto.RegisterType((*WatchEvent)(nil), "definitions.WatchEvent")
+	proto.RegisterType((*CounterRequest)(nil), "definitions.CounterRequest")
+	proto.RegisterType((*AppendRequest)(nil), "definitions.AppendRequest")
+	proto.RegisterType((*TouchRequest)(nil), "definitions.TouchRequest")
+	proto.RegisterType((*ScanRequest)(nil), "definitions.ScanRequest")
+	proto.RegisterType((*ScanReply)(nil), "definitions.ScanReply")
+	proto.RegisterType((*NamespaceRequest)(nil), "definitions.NamespaceRequest")
+	proto.RegisterType((*NamespaceStats)(nil), "definitions.NamespaceStats")
+	proto.RegisterType((*NodeResult)(nil), "definitions.NodeResult")
+	proto.RegisterType((*ClusterReply)(nil), "definitions.ClusterReply")
+	proto.RegisterType((*InvalidateTagRequest)(nil), "definitions.InvalidateTagRequest")
+	proto.RegisterType((*StatsRequest)(nil), "definitions.StatsRequest")
+	proto.RegisterType((*NodeStats)(nil), "definitions.NodeStats")
+	proto.RegisterMapType((map[string]uint64)(nil), "definitions.NodeStats.ForwardedEntry")
+	proto.RegisterMapType((map[string]uint64)(nil), "definitions.NodeStats.RequestsEntry")
+	proto.RegisterType((*StatsReply)(nil), "definitions.StatsReply")
+	proto.RegisterType((*HashSetRequest)(nil), "definitions.HashSetRequest")
+	proto.RegisterMapType((map[string][]byte)(nil), "definitions.HashSetRequest.FieldsEntry")
+	proto.RegisterType((*HashGetRequest)(nil), "definitions.HashGetRequest")
+	proto.RegisterType((*HashIncrRequest)(nil), "definitions.HashIncrRequest")
+	proto.RegisterType((*HashReply)(nil), "definitions.HashReply")
+	proto.RegisterMapType((map[string][]byte)(nil), "definitions.HashReply.FieldsEntry")
+	proto.RegisterType((*ListPushRequest)(nil), "definitions.ListPushRequest")
+	proto.RegisterType((*ListPopRequest)(nil), "definitions.ListPopRequest")
+	proto.RegisterType((*ListRangeRequest)(nil), "definitions.ListRangeRequest")
+	proto.RegisterType((*ListReply)(nil), "definitions.ListReply")
+	proto.RegisterType((*SortedSetMember)(nil), "definitions.SortedSetMember")
+	proto.RegisterType((*SortedSetAddRequest)(nil), "definitions.SortedSetAddRequest")
+	proto.RegisterType((*SortedSetIncrRequest)(nil), "definitions.SortedSetIncrRequest")
+	proto.RegisterType((*SortedSetRangeRequest)(nil), "definitions.SortedSetRangeRequest")
+	proto.RegisterType((*SortedSetScoreRequest)(nil), "definitions.SortedSetScoreRequest")
+	proto.RegisterType((*SortedSetReply)(nil), "definitions.SortedSetReply")
+	proto.RegisterType((*PublishRequest)(nil), "definitions.PublishRequest")
+	proto.RegisterType((*PublishReply)(nil), "definitions.PublishReply")
+	proto.RegisterType((*SubscribeRequest)(nil), "definitions.SubscribeRequest")
+	proto.RegisterType((*Message)(nil), "definitions.Message")
+	proto.RegisterType((*LockRequest)(nil), "definitions.LockRequest")
+	proto.RegisterType((*LockReply)(nil), "definitions.LockReply")
+	proto.RegisterType((*RateLimitRequest)(nil), "definitions.RateLimitRequest")
+	proto.RegisterType((*RateLimitReply)(nil), "definitions.RateLimitReply")
+	proto.RegisterType((*HyperLogLogAddRequest)(nil), "definitions.HyperLogLogAddRequest")
+	proto.RegisterType((*HyperLogLogCountRequest)(nil), "definitions.HyperLogLogCountRequest")
+	proto.RegisterType((*HyperLogLogMergeRequest)(nil), "definitions.HyperLogLogMergeRequest")
+	proto.RegisterType((*HyperLogLogReply)(nil), "definitions.HyperLogLogReply")
+	proto.RegisterType((*BloomCreateRequest)(nil), "definitions.BloomCreateRequest")
+	proto.RegisterType((*BloomRequest)(nil), "definitions.BloomRequest")
+	proto.RegisterType((*BloomReply)(nil), "definitions.BloomReply")
+	proto.RegisterType((*MemberUpdate)(nil), "definitions.MemberUpdate")
+	proto.RegisterType((*PingRequest)(nil), "definitions.PingRequest")
+	proto.RegisterType((*PingReqRequest)(nil), "definitions.PingReqRequest")
+	proto.RegisterType((*PingReply)(nil), "definitions.PingReply")
+	proto.RegisterType((*ErrorReason)(nil), "definitions.ErrorReason")
+}
+
+func init() { proto.RegisterFile("grpc/definitions/definitions.proto", fileDescriptor_671b7d4d1004a799) }
+
+var fileDescriptor_671b7d4d1004a799 = []byte{
+	// 3021 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x3a, 0xcd, 0x73, 0xdb, 0xc6,
+	0xf5, 0x06, 0x09, 0x7e, 0x3d, 0x4a, 0x14, 0x8d, 0x38, 0x32, 0x4d, 0x3b, 0x89, 0x82, 0x49, 0x7e,
+	0xd1, 0xaf, 0x4d, 0xd5, 0x8c, 0xd3, 0x8f, 0x58, 0x89, 0x63, 0x53, 0x12, 0x45, 0x29, 0xa2, 0x2d,
+	0x05, 0x94, 0x93, 0xd4, 0x4d, 0x93, 0xc2, 0xc4, 0x8a, 0xc2, 0x08, 0x04, 0x18, 0x60, 0xa9, 0x98,
+	0x87, 0x9e, 0x3a, 0xd3, 0x4b, 0xa7, 0xbd, 0xb4, 0xb7, 0x4e, 0x3b, 0xd3, 0x9e, 0xda, 0x99, 0x9c,
+	0x3a, 0xfd, 0x3b, 0xfa, 0x17, 0xf4, 0xd0, 0x3f, 0xa5, 0xb3, 0x5f, 0xc0, 0x02, 0x04, 0x40, 0x59,
+	0xf2, 0x6d, 0x1f, 0x76, 0xdf, 0xdb, 0xb7, 0xef, 0x6b, 0xdf, 0xbe, 0x07, 0xd0, 0x47, 0xfe, 0x64,
+	0xf8, 0x43, 0x0b, 0x9d, 0xd8, 0xae, 0x8d, 0x6d, 0xcf, 0x0d, 0xe4, 0xf1, 0xc6, 0xc4, 0xf7, 0xb0,
+	0xa7, 0xd5, 0xa5, 0x4f, 0xfa, 0x3f, 0x14, 0x50, 0xf7, 0x31, 0x1a, 0x6b, 0x4d, 0x28, 0x9e, 0xa1,
+	0x59, 0x4b, 0x59, 0x53, 0xd6, 0x6b, 0x06, 0x19, 0x6a, 0x37, 0xa0, 0x74, 0x6e, 0x3a, 0x53, 0xd4,
+	0x2a, 0xac, 0x29, 0xeb, 0x4b, 0x06, 0x03, 0xb4, 0xd7, 0x01, 0x1c, 0x33, 0xc0, 0x4f, 0x26, 0x96,
+	0x89, 0x51, 0xab, 0xb8, 0xa6, 0xac, 0xab, 0x86, 0xf4, 0x85, 0xcc, 0xa3, 0xe7, 0x13, 0xdb, 0x37,
+	0x09, 0xfd, 0x96, 0xba, 0xa6, 0xac, 0x2f, 0x1b, 0xd2, 0x17, 0x4d, 0x03, 0x15, 0x9b, 0xa3, 0xa0,
+	0x55, 0x5a, 0x2b, 0xae, 0xd7, 0x0c, 0x3a, 0xd6, 0xde, 0x06, 0xf5, 0xcc, 0x76, 0xad, 0x56, 0x79,
+	0x4d, 0x59, 0x6f, 0xdc, 0xbd, 0xbe, 0x21, 0xf3, 0x7c, 0x60, 0xbb, 0x96, 0x41, 0xa7, 0xf5, 0x4f,
+	0x01, 0x3a, 0x96, 0x65, 0xa0, 0x6f, 0xa6, 0x28, 0xc0, 0x04, 0xc9, 0xc6, 0x68, 0x4c, 0x39, 0xae,
+	0x27, 0x90, 0xc8, 0x89, 0x0c, 0x3a, 0xad, 0xdd, 0x81, 0x9a, 0x6b, 0x8e, 0x51, 0x30, 0x31, 0x87,
+	0xec, 0x24, 0x35, 0x23, 0xfa, 0xa0, 0x7f, 0x09, 0xaf, 0x6e, 0x7b, 0xe3, 0x89, 0xe9, 0xa3, 0x8e,
+	0x6b, 0x0d, 0xbe, 0x35, 0x27, 0x2f, 0x95, 0xfa, 0x08, 0x60, 0x80, 0xf0, 0xcb, 0x24, 0x49, 0x94,
+	0xe2, 0x20, 0x33, 0x10, 0x92, 0x67, 0x80, 0xfe, 0x00, 0x96, 0x77, 0x90, 0x83, 0x30, 0x12, 0x7b,
+	0xcd, 0x6b, 0x33, 0x9f, 0xd3, 0x73, 0x68, 0x32, 0x02, 0x1d, 0xc7, 0x11, 0x34, 0x62, 0x18, 0x4a,
+	0x0a, 0x23, 0x16, 0x72, 0xcc, 0x19, 0xa5, 0xb5, 0x6c, 0x30, 0x80, 0xb2, 0xe7, 0x0d, 0x4d, 0x87,
+	0xb2, 0x57, 0x35, 0x18, 0xa0, 0xb5, 0xa0, 0x72, 0xe2, 0x4c, 0x83, 0xd3, 0x0e, 0xa6, 0x06, 0x51,
+	0x34, 0x04, 0xa8, 0x9f, 0x00, 0xf4, 0x10, 0xbe, 0x24, 0xd7, 0xc4, 0x96, 0x1c, 0xcf, 0xb4, 0xf8,
+	0x66, 0x74, 0x1c, 0x09, 0x48, 0xe5, 0x1c, 0x50, 0x01, 0x7d, 0x05, 0x25, 0x03, 0x4d, 0x9c, 0x19,
+	0x61, 0x65, 0x8c, 0x82, 0xc0, 0x1c, 0x89, 0x23, 0x09, 0x30, 0x54, 0x4f, 0x21, 0x5f, 0x3d, 0xe9,
+	0x0a, 0xb8, 0x0b, 0xcd, 0x8e, 0x65, 0x0d, 0x90, 0x7f, 0x8e, 0x7c, 0x71, 0x9a, 0x16, 0x54, 0x4c,
+	0xcb, 0xf2, 0x51, 0x10, 0x88, 0xad, 0x38, 0xf8, 0x89, 0x5a, 0x2d, 0x34, 0x8b, 0xfa, 0x39, 0x34,
+	0xf6, 0x4c, 0xd7, 0x3a, 0x3c, 0x39, 0xc9, 0x3e, 0xff, 0x0d, 0x28, 0x21, 0x17, 0xfb, 0x33, 0xe1,
+	0x83, 0x14, 0x48, 0xf8, 0x58, 0x71, 0xce, 0xc7, 0x62, 0x52, 0x53, 0x93, 0xba, 0xfe, 0x3e, 0x5c,
+	0xdf, 0xf1, 0xbd, 0x49, 0x9c, 0xd9, 0x55, 0x28, 0x07, 0xf4, 0x03, 0xdf, 0x9d, 0x43, 0xfa, 0x2b,
+	0x70, 0xbd, 0x87, 0x30, 0x5b, 0x1b, 0xf0, 0xc5, 0xfa, 0xbf, 0x14, 0x00, 0xf6, 0xa9, 0x6f, 0xb3,
+	0x83, 0xb2, 0xd5, 0xe4, 0xa0, 0xc4, 0xab, 0x05, 0xa8, 0xf5, 0x00, 0xc2, 0x7d, 0x83, 0x56, 0x71,
+	0xad, 0xb8, 0x5e, 0xbf, 0xfb, 0x4e, 0x4c, 0xb2, 0x11, 0x99, 0x8d, 0xc7, 0xe1, 0xca, 0x2e, 0x39,
+	0xa5, 0x21, 0xa1, 0xb6, 0xef, 0xc3, 0x4a, 0x62, 0x7a, 0x51, 0xc0, 0x52, 0x79, 0xc0, 0xda, 0x2c,
+	0x7c, 0xa0, 0x70, 0x81, 0x6f, 0xc3, 0xca, 0xa3, 0xa9, 0x83, 0x6d, 0xc9, 0xe2, 0x34, 0x50, 0xcf,
+	0xd0, 0x4c, 0xf0, 0x4d, 0xc7, 0x0b, 0x3c, 0xe5, 0x0b, 0x4e, 0x44, 0x72, 0xec, 0x77, 0xa0, 0x44,
+	0x4c, 0x83, 0x51, 0x49, 0x35, 0x1d, 0x36, 0xbf, 0x80, 0xf2, 0x2e, 0x68, 0x94, 0x72, 0xdc, 0x93,
+	0x5f, 0x9c, 0x43, 0x17, 0x6a, 0x07, 0x68, 0x66, 0xa0, 0x60, 0xea, 0xa4, 0x99, 0xd4, 0xc5, 0xed,
+	0x1c, 0xf9, 0xbe, 0xe7, 0x53, 0xf3, 0xaa, 0x19, 0x0c, 0x20, 0xdc, 0x0c, 0x3d, 0x0b, 0xf1, 0xb8,
+	0x4e, 0xc7, 0xfa, 0xc7, 0x00, 0x94, 0x6f, 0xe6, 0x60, 0xef, 0x41, 0xc5, 0xa7, 0x5b, 0x0b, 0x71,
+	0xac, 0xc6, 0xc3, 0xb9, 0xe0, 0xcc, 0x10, 0xcb, 0x74, 0x07, 0x96, 0x3e, 0x37, 0xf1, 0xf0, 0x34,
+	0xdb, 0x0b, 0x56, 0xa1, 0x3c, 0xf1, 0xd1, 0x89, 0xfd, 0x9c, 0x32, 0x5d, 0x35, 0x38, 0x94, 0x11,
+	0x6d, 0xf2, 0xad, 0xff, 0xf7, 0x0a, 0x00, 0xdd, 0xae, 0x7b, 0x8e, 0x5c, 0xac, 0xbd, 0x07, 0x2a,
+	0x9e, 0x4d, 0x58, 0x30, 0x68, 0xdc, 0xbd, 0x13, 0xe3, 0x35, 0x5a, 0xb6, 0x71, 0x3c, 0x9b, 0x20,
+	0x83, 0xae, 0xbc, 0xa0, 0xfc, 0xf4, 0x77, 0x40, 0x25, 0x48, 0x5a, 0x05, 0x8a, 0x83, 0xee, 0x71,
+	0xf3, 0x9a, 0x06, 0x50, 0xde, 0xe9, 0xf6, 0xbb, 0xc7, 0xdd, 0xa6, 0x42, 0xc6, 0xdd, 0x2f, 0x8e,
+	0xf6, 0x8d, 0x6e, 0xb3, 0xa0, 0xff, 0x5d, 0x81, 0xc6, 0xb6, 0x37, 0x75, 0x31, 0xf2, 0xb3, 0x25,
+	0xc0, 0xa2, 0x2d, 0x36, 0x85, 0x69, 0x53, 0x80, 0xc8, 0x65, 0xe8, 0x23, 0x71, 0x0f, 0x57, 0x0d,
+	0x0e, 0x11, 0x87, 0xa4, 0x2c, 0x99, 0x8e, 0x88, 0xb7, 0x1c, 0x4c, 0x44, 0x8e, 0x52, 0x7e, 0xe4,
+	0x28, 0x27, 0x65, 0xf7, 0x04, 0x96, 0x3b, 0x93, 0x09, 0x72, 0xad, 0x5c, 0x46, 0x53, 0x92, 0x86,
+	0x18, 0xd9, 0x62, 0x92, 0xec, 0x57, 0xb0, 0x74, 0xec, 0x4d, 0xf3, 0x0c, 0x20, 0xce, 0x76, 0x21,
+	0x9f, 0xed, 0x39, 0xfa, 0x7f, 0x55, 0xa0, 0x3e, 0x18, 0x9a, 0xae, 0x14, 0xeb, 0x86, 0x53, 0x3f,
+	0xf0, 0xc2, 0x58, 0xc7, 0xa0, 0x84, 0x99, 0xd5, 0x42, 0x33, 0x6b, 0x41, 0x65, 0x62, 0x62, 0x8c,
+	0x7c, 0x97, 0xd3, 0x16, 0x20, 0x39, 0xed, 0x90, 0xa8, 0x8e, 0xfb, 0x03, 0x03, 0x22, 0xb3, 0x2c,
+	0x65, 0x9a, 0xe5, 0x9c, 0x68, 0x7f, 0x0a, 0x35, 0xc6, 0x22, 0xf1, 0xa1, 0x34, 0x9f, 0x8f, 0x98,
+	0x2e, 0xc8, 0x4c, 0xeb, 0x5f, 0x42, 0x33, 0x8c, 0x8c, 0x17, 0xbe, 0xb9, 0xbf, 0x99, 0x7a, 0x91,
+	0x2d, 0x51, 0x20, 0xdd, 0x97, 0xf4, 0xff, 0x2a, 0xd0, 0x08, 0xc9, 0x0f, 0xb0, 0x89, 0x83, 0x4b,
+	0x11, 0x6f, 0x41, 0x85, 0xdc, 0x5c, 0x36, 0xbd, 0x04, 0xa8, 0x41, 0x72, 0x90, 0x1c, 0xf5, 0xd4,
+	0xc6, 0x01, 0xb7, 0x53, 0x3a, 0x26, 0x47, 0x1d, 0xdb, 0x41, 0x80, 0x02, 0x2a, 0xc0, 0xa2, 0xc1,
+	0x21, 0xb2, 0x33, 0x3a, 0xb7, 0x87, 0xd4, 0xd5, 0xa8, 0x04, 0x8b, 0x46, 0xf4, 0x41, 0x5b, 0x83,
+	0x7a, 0x64, 0x11, 0x41, 0xab, 0x42, 0xe7, 0xe5, 0x4f, 0x84, 0xb7, 0x67, 0x33, 0x8c, 0x82, 0x56,
+	0x95, 0xf1, 0x46, 0x01, 0xdd, 0x01, 0x78, 0xec, 0x59, 0x88, 0xc7, 0xcb, 0xcc, 0x4b, 0x3b, 0x0a,
+	0x88, 0x85, 0xb4, 0x80, 0x58, 0x8c, 0x02, 0xa2, 0xd6, 0x86, 0xaa, 0x79, 0x72, 0x82, 0x86, 0x18,
+	0x59, 0xf4, 0x5c, 0xaa, 0x11, 0xc2, 0xfa, 0x7d, 0x58, 0xda, 0x76, 0xa6, 0x01, 0x75, 0x76, 0xa2,
+	0xea, 0x1f, 0x40, 0xc9, 0xf5, 0x2c, 0x24, 0x82, 0xe5, 0xcd, 0x58, 0x38, 0x89, 0xf8, 0x32, 0xd8,
+	0x2a, 0xfd, 0x4b, 0xb8, 0xb1, 0xef, 0x9e, 0x9b, 0x8e, 0x6d, 0x99, 0x18, 0x1d, 0x9b, 0x23, 0xc9,
+	0x65, 0xb0, 0x39, 0x12, 0x2e, 0x83, 0xcd, 0xd1, 0x05, 0xd2, 0xc8, 0x79, 0x6d, 0xaf, 0xc3, 0x12,
+	0xd5, 0xb1, 0x94, 0xc1, 0x0c, 0x19, 0xb3, 0x94, 0x72, 0xd5, 0x10, 0xa0, 0xfe, 0x4f, 0x15, 0x6a,
+	0x84, 0x3b, 0x66, 0x12, 0xd9, 0x42, 0x93, 0x14, 0x5f, 0x48, 0x57, 0x7c, 0x31, 0x55, 0xf1, 0x6a,
+	0xb6, 0xe2, 0x4b, 0x0b, 0x14, 0x5f, 0xce, 0x51, 0x7c, 0x45, 0x52, 0x3c, 0x51, 0xd3, 0xd0, 0x9c,
+	0x98, 0x43, 0x1b, 0xcf, 0xb8, 0x45, 0x84, 0xb0, 0xf6, 0x10, 0xaa, 0x3e, 0x13, 0x42, 0xd0, 0xaa,
+	0x51, 0xcd, 0xbc, 0x35, 0xa7, 0x19, 0x7a, 0xf6, 0x0d, 0x2e, 0x2b, 0x9e, 0xb3, 0x84, 0x58, 0xda,
+	0x36, 0xd4, 0x4e, 0x3c, 0xff, 0x5b, 0xd3, 0xb7, 0x90, 0xd5, 0x02, 0x4a, 0xe2, 0xed, 0x0c, 0x12,
+	0xbb, 0x62, 0x1d, 0xa3, 0x11, 0xe1, 0x69, 0x1f, 0xc6, 0xf2, 0xa7, 0x3a, 0xa5, 0x72, 0x3b, 0x4e,
+	0x25, 0xe6, 0x9c, 0x72, 0xce, 0x14, 0x19, 0xec, 0x92, 0x64, 0xb0, 0xed, 0x0f, 0x61, 0x39, 0xc6,
+	0xf2, 0x8b, 0xe4, 0x51, 0xed, 0x8f, 0xa0, 0x11, 0x67, 0xf6, 0x45, 0xb0, 0xf5, 0x53, 0x00, 0x6e,
+	0x5e, 0xc4, 0xf2, 0xdf, 0x8d, 0x5b, 0xfe, 0x6a, 0xba, 0x70, 0xb8, 0xe1, 0x93, 0xd5, 0xd8, 0xc3,
+	0xa6, 0xc3, 0xaf, 0xdd, 0xcc, 0xd5, 0x74, 0x91, 0xfe, 0x1f, 0x85, 0xe4, 0xd6, 0xc1, 0xe9, 0x20,
+	0xef, 0x6d, 0xf1, 0x00, 0xca, 0x27, 0x36, 0x72, 0x2c, 0x62, 0x9a, 0xf3, 0x89, 0x69, 0x1c, 0x7d,
+	0x63, 0x97, 0xae, 0x64, 0x0a, 0xe2, 0x68, 0x57, 0x4b, 0xc3, 0xdb, 0xf7, 0xa0, 0x2e, 0x11, 0xbd,
+	0xe8, 0x55, 0x4a, 0x05, 0xf9, 0x05, 0x3b, 0x5d, 0xee, 0xcb, 0x69, 0x35, 0x76, 0xba, 0x5a, 0xc8,
+	0x74, 0xfe, 0x55, 0xf9, 0x3b, 0x05, 0x56, 0x08, 0xe9, 0x7d, 0x77, 0x98, 0x9f, 0x8d, 0x50, 0x6a,
+	0x22, 0x14, 0x52, 0x20, 0xca, 0x51, 0x98, 0x4b, 0x33, 0x60, 0x61, 0x3d, 0x20, 0xc6, 0x4f, 0x29,
+	0xc9, 0xcf, 0xbf, 0x15, 0xa8, 0x11, 0x7e, 0x98, 0xc9, 0x6c, 0x86, 0x67, 0x62, 0x36, 0xa3, 0xcf,
+	0x69, 0x8c, 0xae, 0xcb, 0x52, 0x96, 0x54, 0xb7, 0x28, 0xcc, 0xd5, 0x2d, 0xc2, 0xab, 0xbc, 0x98,
+	0xb8, 0xca, 0x99, 0x0e, 0x58, 0x40, 0x62, 0xc0, 0x55, 0x54, 0x37, 0x83, 0x15, 0xf2, 0xd8, 0x39,
+	0x9a, 0x06, 0xf9, 0xf9, 0x2e, 0xc5, 0x60, 0xba, 0x5b, 0x32, 0x38, 0x74, 0xc5, 0x77, 0xdf, 0x04,
+	0x1a, 0x74, 0x6b, 0x6f, 0x92, 0xab, 0x59, 0x26, 0x85, 0x82, 0x2c, 0x85, 0x16, 0x54, 0xb0, 0x3d,
+	0x46, 0xde, 0x54, 0x48, 0x47, 0x80, 0x0b, 0x76, 0x74, 0xa0, 0x49, 0x76, 0x34, 0x4c, 0x77, 0x84,
+	0x72, 0xf7, 0x0c, 0xb0, 0xe9, 0x63, 0x7e, 0x43, 0x30, 0x80, 0xdc, 0x0f, 0x01, 0xf6, 0x26, 0xe2,
+	0x7e, 0x20, 0xe3, 0x05, 0xbb, 0xfd, 0x1c, 0x6a, 0x74, 0x37, 0x6a, 0x2a, 0x91, 0x08, 0x95, 0x98,
+	0x08, 0x57, 0xa1, 0xec, 0x20, 0x77, 0x84, 0x4f, 0xb9, 0x09, 0x70, 0x68, 0x51, 0x59, 0x4b, 0x7f,
+	0x00, 0x2b, 0x03, 0xcf, 0xc7, 0xc8, 0x1a, 0x20, 0xfc, 0x08, 0x8d, 0x9f, 0x21, 0x9a, 0x2e, 0x8e,
+	0xe9, 0x48, 0xa4, 0x91, 0x0c, 0xa2, 0xe7, 0x19, 0x7a, 0x3e, 0x53, 0xbe, 0x62, 0x30, 0x40, 0xff,
+	0xb3, 0x02, 0xaf, 0x84, 0x14, 0xa4, 0x32, 0xd6, 0xbc, 0x3c, 0x7e, 0x02, 0x15, 0x46, 0x49, 0x04,
+	0xa6, 0xf8, 0xab, 0x24, 0xc1, 0x86, 0x21, 0x16, 0x5f, 0xd1, 0x3a, 0xfe, 0xa8, 0xc0, 0x8d, 0x90,
+	0x74, 0xbe, 0xfb, 0x47, 0x07, 0x2f, 0x24, 0x0f, 0x1e, 0x05, 0x00, 0xe5, 0xe5, 0x04, 0x80, 0xdf,
+	0x2a, 0xf0, 0x6a, 0xc8, 0xd6, 0x4b, 0x33, 0xa4, 0x16, 0x79, 0xa4, 0x92, 0x0a, 0x85, 0x28, 0x13,
+	0x09, 0x70, 0x01, 0x37, 0x7f, 0x92, 0xb9, 0x19, 0x10, 0xbd, 0x66, 0x73, 0xd3, 0x84, 0xe2, 0xd8,
+	0x76, 0xb9, 0x11, 0x90, 0x21, 0xfd, 0x62, 0x3e, 0xe7, 0xd2, 0x21, 0xc3, 0x1c, 0x3e, 0x48, 0x82,
+	0x66, 0x8f, 0x6d, 0xcc, 0xdf, 0x68, 0x0c, 0x58, 0xf0, 0x86, 0xf8, 0x4e, 0x81, 0x46, 0x24, 0x2b,
+	0xea, 0x06, 0x92, 0x2d, 0x29, 0x2f, 0x68, 0x4b, 0x97, 0x8b, 0x96, 0xcc, 0xf2, 0x55, 0xc9, 0xf2,
+	0x25, 0x97, 0x2b, 0xc9, 0x2e, 0xa7, 0xef, 0x40, 0xe3, 0x68, 0xfa, 0xcc, 0xb1, 0x83, 0x53, 0x39,
+	0xdf, 0x3c, 0x35, 0x5d, 0x17, 0x39, 0x22, 0x8f, 0xe4, 0x20, 0x7b, 0x82, 0xcd, 0x68, 0xb1, 0x8f,
+	0x85, 0x54, 0x01, 0xea, 0xef, 0xc2, 0x52, 0x48, 0x85, 0x9c, 0xf8, 0x0e, 0xd4, 0x7c, 0x34, 0x44,
+	0x36, 0x2f, 0x47, 0x11, 0xee, 0xa2, 0x0f, 0xfa, 0x2f, 0xa1, 0x39, 0x98, 0x3e, 0x0b, 0x86, 0xbe,
+	0xfd, 0x2c, 0x54, 0x1d, 0xc9, 0x03, 0xd9, 0x36, 0xe2, 0xc5, 0x15, 0xc2, 0x64, 0x8e, 0xbf, 0xf5,
+	0xc4, 0x3d, 0x1a, 0xc2, 0x19, 0x39, 0xf4, 0xe7, 0x50, 0x79, 0xc4, 0x2b, 0x8a, 0x0b, 0x8e, 0xc3,
+	0x5e, 0x94, 0x85, 0xf8, 0x8b, 0x52, 0x3a, 0x68, 0x31, 0x7e, 0xd0, 0x5f, 0x41, 0xbd, 0xef, 0x0d,
+	0xcf, 0x72, 0x0d, 0x0e, 0x63, 0x87, 0x47, 0x6e, 0x32, 0x24, 0x1c, 0x62, 0xef, 0x0c, 0xb9, 0xa2,
+	0x56, 0x49, 0x01, 0xa2, 0x8f, 0x53, 0xcf, 0xb1, 0x90, 0xcf, 0x83, 0x00, 0x87, 0x16, 0x98, 0xfe,
+	0x3d, 0xa8, 0xb1, 0xed, 0x89, 0x90, 0x43, 0xc2, 0x4a, 0x3a, 0xe1, 0x82, 0x4c, 0x58, 0xff, 0x8d,
+	0x02, 0x4d, 0xc3, 0xc4, 0xa8, 0x4f, 0x6c, 0x38, 0x9b, 0x7f, 0x39, 0x1f, 0x67, 0x5e, 0x13, 0xc2,
+	0xc4, 0x89, 0x7d, 0x11, 0x98, 0x15, 0x83, 0x8e, 0xd9, 0xd3, 0x2b, 0xc0, 0xdc, 0xd8, 0xe8, 0x78,
+	0xc1, 0x19, 0x4e, 0xa1, 0x21, 0xf1, 0xc1, 0xcb, 0xc1, 0xa6, 0xe3, 0x78, 0xdf, 0x22, 0x4b, 0xbc,
+	0x70, 0x38, 0xc8, 0xec, 0x68, 0x6c, 0xda, 0xae, 0xed, 0x8e, 0x38, 0x3b, 0xd1, 0x07, 0xe2, 0x1f,
+	0x3e, 0xc2, 0xfe, 0xac, 0x73, 0x42, 0x1e, 0x47, 0x3c, 0xd6, 0x46, 0x5f, 0xf4, 0x5f, 0x2b, 0xf0,
+	0xea, 0xde, 0x6c, 0x82, 0xfc, 0xbe, 0x37, 0xea, 0x7b, 0xa3, 0xdc, 0x78, 0xdf, 0x86, 0x2a, 0x72,
+	0xd0, 0x18, 0xb9, 0x58, 0xdc, 0xf7, 0x21, 0x7c, 0xc5, 0x98, 0x7e, 0x00, 0x37, 0x25, 0x26, 0x68,
+	0x91, 0xe9, 0xf2, 0x65, 0xc5, 0x3f, 0x28, 0x31, 0x6a, 0x8f, 0x90, 0x1f, 0xc5, 0xe2, 0x35, 0xa8,
+	0x5b, 0x28, 0xc0, 0xb6, 0xcb, 0xf8, 0x64, 0x87, 0x93, 0x3f, 0x11, 0x41, 0x07, 0xde, 0xd4, 0x1f,
+	0x22, 0xe1, 0x47, 0x02, 0xbc, 0xe2, 0x11, 0xb7, 0xa0, 0x29, 0x31, 0x15, 0x2a, 0x95, 0x38, 0xda,
+	0x28, 0x52, 0x2a, 0x07, 0xe3, 0xe9, 0x8d, 0xca, 0xc3, 0x96, 0xfe, 0x17, 0x05, 0xb4, 0x2d, 0xc7,
+	0xf3, 0xc6, 0xdb, 0xb4, 0x7c, 0x76, 0x71, 0x0b, 0x95, 0x5f, 0x8c, 0xe4, 0x8d, 0x4a, 0x1e, 0x58,
+	0x46, 0x64, 0xa6, 0xd1, 0x87, 0x2b, 0x5e, 0x82, 0x4f, 0x61, 0x89, 0xf2, 0x77, 0x39, 0x1b, 0xca,
+	0xcf, 0xf8, 0xff, 0x0f, 0x80, 0xd3, 0xe6, 0xa2, 0x93, 0xab, 0xb7, 0xd5, 0xa8, 0x4a, 0xfb, 0x9d,
+	0x02, 0x4b, 0xec, 0x96, 0xe0, 0x57, 0x40, 0xf6, 0xa3, 0xff, 0xc7, 0xf4, 0x1e, 0xe6, 0xf7, 0x46,
+	0xe3, 0xee, 0x1b, 0xb1, 0x2b, 0x47, 0xa6, 0xb1, 0x41, 0x1e, 0x6d, 0xc8, 0x60, 0xab, 0x89, 0x11,
+	0xd9, 0xee, 0xd0, 0xf4, 0xdd, 0xc8, 0x12, 0x54, 0x43, 0xfe, 0xa4, 0xff, 0x3f, 0x94, 0x28, 0x86,
+	0x56, 0x83, 0x52, 0xa7, 0xbf, 0xff, 0x59, 0xb7, 0x79, 0x4d, 0xab, 0x43, 0x65, 0xf0, 0x64, 0x70,
+	0xd4, 0xdd, 0x3e, 0x6e, 0x2a, 0x5a, 0x15, 0xd4, 0x9d, 0x6e, 0x67, 0xa7, 0x59, 0xd0, 0x3f, 0x83,
+	0xfa, 0x91, 0xed, 0x86, 0xf5, 0x91, 0xf7, 0xa1, 0x32, 0xa5, 0x5b, 0x8a, 0x7b, 0xf0, 0x56, 0x26,
+	0x53, 0x86, 0x58, 0x49, 0x33, 0x87, 0x99, 0x3b, 0xe4, 0x45, 0x67, 0x3a, 0xd6, 0x7f, 0x01, 0x0d,
+	0x4e, 0x57, 0xaa, 0x26, 0x62, 0xd3, 0x1f, 0x21, 0x2c, 0xd2, 0x40, 0x06, 0xc9, 0x5b, 0x16, 0x2e,
+	0xba, 0xa5, 0x6e, 0x40, 0x8d, 0x91, 0x27, 0xca, 0xb8, 0x14, 0xd3, 0x4d, 0x28, 0x9a, 0xc3, 0x33,
+	0xce, 0x33, 0x19, 0xea, 0x6f, 0x43, 0xbd, 0x4b, 0x0d, 0x11, 0x99, 0x81, 0x47, 0xa3, 0xb4, 0x4f,
+	0x47, 0x82, 0x5f, 0x06, 0x7d, 0xcf, 0x05, 0x95, 0xf4, 0x5a, 0x49, 0x6d, 0x7a, 0x70, 0x6c, 0xec,
+	0x3f, 0xee, 0x35, 0xaf, 0x11, 0x79, 0xee, 0x75, 0x06, 0x7b, 0x4c, 0xb2, 0xfd, 0xfd, 0xc1, 0x71,
+	0xb3, 0xa0, 0x35, 0x00, 0x06, 0x87, 0xc6, 0x71, 0x77, 0xe7, 0x6b, 0x52, 0xd7, 0x2e, 0xd2, 0x99,
+	0xc3, 0xed, 0x83, 0xa6, 0xaa, 0x35, 0x61, 0xe9, 0xf8, 0xf0, 0xa0, 0xfb, 0xf8, 0xeb, 0xad, 0x27,
+	0xdb, 0x07, 0xdd, 0xe3, 0x66, 0x49, 0x5b, 0x81, 0xfa, 0xde, 0xcf, 0x8e, 0xba, 0x46, 0xff, 0xb0,
+	0xd7, 0x3f, 0xec, 0x35, 0xcb, 0x44, 0x71, 0x5b, 0xfd, 0xc3, 0xc3, 0x47, 0xcd, 0xca, 0xdd, 0xbf,
+	0xbd, 0x0e, 0x15, 0xcb, 0x1f, 0x9a, 0xc3, 0x53, 0xa4, 0xfd, 0x08, 0x8a, 0x1d, 0xcb, 0xd2, 0xe2,
+	0xd5, 0xaf, 0x28, 0x68, 0xb6, 0xb5, 0xd8, 0x04, 0x95, 0x8e, 0x7e, 0x8d, 0x60, 0x0d, 0x10, 0x4e,
+	0x60, 0x45, 0x6f, 0xf6, 0x0c, 0xac, 0x4d, 0x28, 0xb3, 0x0e, 0x8b, 0xd6, 0x8e, 0xcd, 0xc7, 0xda,
+	0x2e, 0x19, 0xb8, 0x3d, 0xa8, 0x85, 0x6d, 0x52, 0xed, 0xb5, 0x14, 0xf4, 0xa8, 0x7d, 0xda, 0x8e,
+	0x2b, 0x4b, 0x2e, 0xfa, 0x31, 0xd6, 0x7b, 0x73, 0xac, 0xf7, 0x16, 0xb1, 0xfe, 0x10, 0x6a, 0x61,
+	0x97, 0x31, 0xb1, 0x7d, 0xb2, 0xfb, 0x98, 0x79, 0x00, 0x88, 0xda, 0x79, 0xda, 0xeb, 0xc9, 0xed,
+	0xe3, 0x7d, 0xbe, 0xf6, 0xcd, 0x8c, 0x56, 0x9d, 0x7e, 0x4d, 0xdb, 0x02, 0x88, 0x9a, 0x88, 0x09,
+	0x42, 0x73, 0xdd, 0xc5, 0x0c, 0x66, 0x3e, 0x82, 0x0a, 0x6f, 0x80, 0x6a, 0xb7, 0x13, 0x2f, 0x79,
+	0xb9, 0x2d, 0x9a, 0x81, 0xfd, 0x09, 0x34, 0xe2, 0xad, 0x7b, 0x2d, 0x5e, 0x0e, 0x48, 0xed, 0xeb,
+	0x67, 0xd0, 0xda, 0x86, 0xaa, 0xe8, 0x0c, 0x6a, 0xf1, 0x0c, 0x39, 0xd1, 0x30, 0x6c, 0xdf, 0x9c,
+	0x9f, 0x4d, 0x12, 0x19, 0xa4, 0x13, 0x19, 0x5c, 0x88, 0xc8, 0x3e, 0xd4, 0xa5, 0x26, 0xa0, 0xf6,
+	0xc6, 0xfc, 0xca, 0xb8, 0x9d, 0xe6, 0x90, 0x7a, 0x00, 0x25, 0xda, 0xc1, 0xd2, 0x6e, 0xcd, 0x77,
+	0xb5, 0xd2, 0xd1, 0xa3, 0x86, 0x97, 0x7e, 0xed, 0x3d, 0x45, 0xbb, 0x07, 0x2a, 0x79, 0x08, 0x26,
+	0x94, 0x13, 0xef, 0x55, 0x65, 0x08, 0xf4, 0x1e, 0xa8, 0x3b, 0xe8, 0x72, 0xa8, 0x9b, 0x50, 0x66,
+	0x4d, 0xa6, 0x84, 0x7f, 0xc6, 0x3a, 0x4f, 0x19, 0xb8, 0x1f, 0x42, 0xe5, 0xc8, 0x47, 0x97, 0x44,
+	0xfe, 0x00, 0x4a, 0xb4, 0x0d, 0x95, 0x90, 0x97, 0xdc, 0x9a, 0xca, 0xc0, 0xfc, 0x18, 0xea, 0x3d,
+	0x84, 0x3b, 0xae, 0x75, 0x49, 0xfc, 0x4d, 0x50, 0x49, 0xf3, 0x47, 0x6b, 0xc5, 0xfd, 0x2d, 0x6a,
+	0x59, 0xb5, 0x57, 0x53, 0x66, 0x18, 0xee, 0x2e, 0xac, 0xb0, 0xb4, 0x25, 0xac, 0x04, 0x27, 0x22,
+	0x43, 0xb2, 0x3b, 0x94, 0xc1, 0xc3, 0xa7, 0x70, 0x7d, 0x07, 0xb1, 0x87, 0xd1, 0x85, 0x29, 0xe5,
+	0x95, 0xa2, 0xf5, 0x6b, 0xda, 0x21, 0x2c, 0xc7, 0x9a, 0x15, 0xda, 0x9b, 0xf1, 0x66, 0x69, 0x4a,
+	0x23, 0x23, 0x3f, 0x6a, 0xde, 0x67, 0xf7, 0x7f, 0x90, 0x90, 0xb0, 0xdc, 0xb3, 0x68, 0xdf, 0x4c,
+	0x9b, 0x12, 0xe8, 0xea, 0x1e, 0x71, 0xce, 0xdb, 0x39, 0x85, 0xde, 0xf6, 0xea, 0xdc, 0xa4, 0x8c,
+	0xde, 0x4b, 0x45, 0xef, 0x5d, 0x14, 0x7d, 0x07, 0x39, 0x97, 0x45, 0x7f, 0x00, 0xa5, 0x3d, 0xea,
+	0x8d, 0x77, 0xe6, 0x96, 0x48, 0xd5, 0x9a, 0x1c, 0x02, 0x0f, 0xa1, 0x42, 0xd8, 0xef, 0x38, 0x57,
+	0x61, 0xa1, 0x4f, 0x0a, 0x97, 0x09, 0x16, 0x12, 0xf5, 0xcc, 0xf6, 0xea, 0xdc, 0xac, 0x44, 0xc0,
+	0xb8, 0x12, 0x81, 0xfb, 0xa0, 0xf6, 0x8f, 0xbc, 0x89, 0x76, 0x7b, 0x6e, 0x45, 0x54, 0xd5, 0xcc,
+	0x47, 0x37, 0xae, 0x80, 0xfe, 0x31, 0x49, 0x5e, 0xae, 0x88, 0x7f, 0x95, 0xfd, 0x3b, 0x50, 0xee,
+	0xd3, 0x12, 0x98, 0xf6, 0xda, 0xfc, 0x1a, 0xa9, 0x34, 0x96, 0x43, 0xa2, 0x07, 0xea, 0x53, 0x92,
+	0x69, 0xad, 0xa5, 0x97, 0x81, 0xa4, 0x94, 0xeb, 0x76, 0xfa, 0x0a, 0x41, 0xe8, 0x00, 0x2a, 0x4f,
+	0x89, 0xdd, 0x6d, 0xcd, 0xb4, 0x37, 0xd3, 0x57, 0xca, 0x56, 0xb9, 0x90, 0x58, 0xf9, 0x29, 0x3b,
+	0x98, 0x9e, 0xb1, 0x50, 0x3e, 0xdd, 0x02, 0x62, 0x06, 0x2c, 0x33, 0x62, 0x5b, 0x33, 0x5a, 0xa1,
+	0xcb, 0xa2, 0x29, 0x97, 0xef, 0x16, 0xd1, 0x3c, 0x86, 0x95, 0xa7, 0x06, 0x1a, 0x73, 0xb2, 0x86,
+	0xe9, 0x9e, 0xbd, 0x0c, 0x4e, 0x9f, 0x40, 0x53, 0xa2, 0xfa, 0xd2, 0x98, 0xdd, 0x86, 0x0a, 0xaf,
+	0x88, 0x25, 0x0c, 0x2d, 0x5e, 0x6d, 0x6b, 0xdf, 0x4a, 0x9f, 0x64, 0x44, 0x76, 0xa0, 0x16, 0x16,
+	0xca, 0x12, 0xe6, 0x96, 0x2c, 0xa0, 0xb5, 0x6f, 0x24, 0x9e, 0x25, 0xb4, 0xfa, 0x45, 0x33, 0x88,
+	0x4d, 0x50, 0x49, 0xd1, 0x28, 0x71, 0xb1, 0x49, 0x65, 0xac, 0xf6, 0x6a, 0xca, 0x8c, 0xc8, 0x0e,
+	0xcb, 0x4f, 0x5c, 0xe7, 0xb2, 0xd8, 0x1d, 0xa8, 0x1b, 0xe8, 0xc4, 0x47, 0xc1, 0xe9, 0xa5, 0x19,
+	0xd8, 0x87, 0x5a, 0x58, 0x2d, 0x4a, 0x88, 0x20, 0x59, 0xcd, 0x6a, 0xdf, 0xce, 0x9a, 0x66, 0xa4,
+	0xfa, 0x50, 0x3a, 0xda, 0x25, 0x7e, 0x97, 0xe8, 0x58, 0xa5, 0x55, 0x88, 0xda, 0xaf, 0x65, 0xad,
+	0x11, 0xd4, 0x8e, 0xa0, 0x72, 0xb4, 0x4b, 0x73, 0x29, 0xed, 0xad, 0xac, 0xb5, 0x72, 0xb1, 0xe7,
+	0x82, 0x14, 0x69, 0x45, 0x27, 0x9b, 0xa2, 0x5c, 0xf0, 0x59, 0x4c, 0x71, 0x17, 0xaa, 0x5b, 0xbb,
+	0x2c, 0x31, 0x49, 0x24, 0xb1, 0xf3, 0x95, 0x96, 0xf6, 0xcd, 0xf9, 0x05, 0xd2, 0x95, 0xbf, 0x45,
+	0x25, 0x77, 0x2b, 0x6d, 0xcd, 0x42, 0xf4, 0x87, 0x84, 0x8d, 0xee, 0x73, 0x3b, 0x98, 0x4b, 0x1a,
+	0x2e, 0x4a, 0x61, 0x13, 0x54, 0xf2, 0x22, 0x4f, 0x58, 0x90, 0x54, 0x5b, 0x68, 0xaf, 0xa6, 0xcc,
+	0x84, 0x57, 0x2e, 0x5f, 0x98, 0xf4, 0xc4, 0x58, 0x09, 0x21, 0x9b, 0xc2, 0xb3, 0x32, 0xfd, 0x65,
+	0xfb, 0xfd, 0xff, 0x0d, 0x00, 0x8f, 0x1d, 0x52, 0x0f, 0xd8, 0x2d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// DrcacheClient is the client API for Drcache service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DrcacheClient interface {
+	Add(ctx context.Context, in *AddRequest, opts ...grpc.CallOption) (*Reply, error)
+	Set(ctx context.Context, in *SetRequest, opts ...grpc.CallOption) (*Reply, error)
+	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*Reply, error)
+	DeleteAll(ctx context.Context, in *DeleteAllRequest, opts ...grpc.CallOption) (*ClusterReply, error)
+	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Reply, error)
+	AddServer(ctx context.Context, in *AddServerRequest, opts ...grpc.CallOption) (*Reply, error)
+	GetServers(ctx context.Context, in *GetServersRequest, opts ...grpc.CallOption) (*ServerList, error)
+	DropServer(ctx context.Context, in *DropServerRequest, opts ...grpc.CallOption) (*Reply, error)
+	HandOff(ctx context.Context, in *HandOffRequest, opts ...grpc.CallOption) (*Reply, error)
+	CompareAndSwap(ctx context.Context, in *CompareAndSwapRequest, opts ...grpc.CallOption) (*Reply, error)
+	MultiGet(ctx context.Context, in *MultiGetRequest, opts ...grpc.CallOption) (*MultiReply, error)
+	MultiSet(ctx context.Context, in *MultiSetRequest, opts ...grpc.CallOption) (*MultiReply, error)
+	MultiDelete(ctx context.Context, in *MultiDeleteRequest, opts ...grpc.CallOption) (*MultiReply, error)
+	Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (Drcache_WatchClient, error)
+	Incr(ctx context.Context, in *CounterRequest, opts ...grpc.CallOption) (*Reply, error)
+	Decr(ctx context.Context, in *CounterRequest, opts ...grpc.CallOption) (*Reply, error)
+	Append(ctx context.Context, in *AppendRequest, opts ...grpc.CallOption) (*Reply, error)
+	Prepend(ctx context.Context, in *AppendRequest, opts ...grpc.CallOption) (*Reply, error)
+	Touch(ctx context.Context, in *TouchRequest, opts ...grpc.CallOption) (*Reply, error)
+	GetAndTouch(ctx context.Context, in *TouchRequest, opts ...grpc.CallOption) (*Reply, error)
+	Scan(ctx context.Context, in *ScanRequest, opts ...grpc.CallOption) (*ScanReply, error)
+	CreateNamespace(ctx context.Context, in *NamespaceRequest, opts ...grpc.CallOption) (*Reply, error)
+	DescribeNamespace(ctx context.Context, in *NamespaceRequest, opts ...grpc.CallOption) (*NamespaceStats, error)
+	InvalidateTag(ctx context.Context, in *InvalidateTagRequest, opts ...grpc.CallOption) (*ClusterReply, error)
+	Stats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*StatsReply, error)
+	HSet(ctx context.Context, in *HashSetRequest, opts ...grpc.CallOption) (*HashReply, error)
+	HGet(ctx context.Context, in *HashGetRequest, opts ...grpc.CallOption) (*HashReply, error)
+	HDel(ctx context.Context, in *HashGetRequest, opts ...grpc.CallOption) (*HashReply, error)
+	HIncr(ctx context.Context, in *HashIncrRequest, opts ...grpc.CallOption) (*HashReply, error)
+	HGetAll(ctx context.Context, in *HashGetRequest, opts ...grpc.CallOption) (*HashReply, error)
+	LPush(ctx context.Context, in *ListPushRequest, opts ...grpc.CallOption) (*ListReply, error)
+	RPush(ctx context.Context, in *ListPushRequest, opts ...grpc.CallOption) (*ListReply, error)
+	LPop(ctx context.Context, in *ListPopRequest, opts ...grpc.CallOption) (*ListReply, error)
+	RPop(ctx context.Context, in *ListPopRequest, opts ...grpc.CallOption) (*ListReply, error)
+	BLPop(ctx context.Context, in *ListPopRequest, opts ...grpc.CallOption) (*ListReply, error)
+	BRPop(ctx context.Context, in *ListPopRequest, opts ...grpc.CallOption) (*ListReply, error)
+	LRange(ctx context.Context, in *ListRangeRequest, opts ...grpc.CallOption) (*ListReply, error)
+	ZAdd(ctx context.Context, in *SortedSetAddRequest, opts ...grpc.CallOption) (*SortedSetReply, error)
+	ZIncrBy(ctx context.Context, in *SortedSetIncrRequest, opts ...grpc.CallOption) (*SortedSetReply, error)
+	ZRange(ctx context.Context, in *SortedSetRangeRequest, opts ...grpc.CallOption) (*SortedSetReply, error)
+	ZRangeByScore(ctx context.Context, in *SortedSetScoreRequest, opts ...grpc.CallOption) (*SortedSetReply, error)
+	ZRemRangeByRank(ctx context.Context, in *SortedSetRangeRequest, opts ...grpc.CallOption) (*SortedSetReply, error)
+	ZRemRangeByScore(ctx context.Context, in *SortedSetScoreRequest, opts ...grpc.CallOption) (*SortedSetReply, error)
+	Publish(ctx context.Context, in *PublishRequest, opts ...grpc.CallOption) (*PublishReply, error)
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Drcache_SubscribeClient, error)
+	Lock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*LockReply, error)
+	Unlock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*LockReply, error)
+	RefreshLock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*LockReply, error)
+	RateLimit(ctx context.Context, in *RateLimitRequest, opts ...grpc.CallOption) (*RateLimitReply, error)
+	PFAdd(ctx context.Context, in *HyperLogLogAddRequest, opts ...grpc.CallOption) (*HyperLogLogReply, error)
+	PFCount(ctx context.Context, in *HyperLogLogCountRequest, opts ...grpc.CallOption) (*HyperLogLogReply, error)
+	PFMerge(ctx context.Context, in *HyperLogLogMergeRequest, opts ...grpc.CallOption) (*HyperLogLogReply, error)
+	BFCreate(ctx context.Context, in *BloomCreateRequest, opts ...grpc.CallOption) (*BloomReply, error)
+	BFAdd(ctx context.Context, in *BloomRequest, opts ...grpc.CallOption) (*BloomReply, error)
+	BFExists(ctx context.Context, in *BloomRequest, opts ...grpc.CallOption) (*BloomReply, error)
+	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingReply, error)
+	PingReq(ctx context.Context, in *PingReqRequest, opts ...grpc.CallOption) (*PingReply, error)
+}
+
+type drcacheClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDrcacheClient(cc *grpc.ClientConn) DrcacheClient {
+	return &drcacheClient{cc}
+}
+
+func (c *drcacheClient) Add(ctx context.Context, in *AddRequest, opts ...grpc.CallOption) (*Reply, error) {
+	out := new(Reply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/Add", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) Set(ctx context.Context, in *SetRequest, opts ...grpc.CallOption) (*Reply, error) {
+	out := new(Reply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/Set", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*Reply, error) {
+	out := new(Reply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) DeleteAll(ctx context.Context, in *DeleteAllRequest, opts ...grpc.CallOption) (*ClusterReply, error) {
+	out := new(ClusterReply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/DeleteAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Reply, error) {
+	out := new(Reply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) AddServer(ctx context.Context, in *AddServerRequest, opts ...grpc.CallOption) (*Reply, error) {
+	out := new(Reply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/AddServer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
 func (c *drcacheClient) GetServers(ctx context.Context, in *GetServersRequest, opts ...grpc.CallOption) (*ServerList, error) {
@@ -647,203 +4359,1774 @@ func (c *drcacheClient) GetServers(ctx context.Context, in *GetServersRequest, o
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *drcacheClient) DropServer(ctx context.Context, in *DropServerRequest, opts ...grpc.CallOption) (*Reply, error) {
+	out := new(Reply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/DropServer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) HandOff(ctx context.Context, in *HandOffRequest, opts ...grpc.CallOption) (*Reply, error) {
+	out := new(Reply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/HandOff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) CompareAndSwap(ctx context.Context, in *CompareAndSwapRequest, opts ...grpc.CallOption) (*Reply, error) {
+	out := new(Reply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/CompareAndSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) MultiGet(ctx context.Context, in *MultiGetRequest, opts ...grpc.CallOption) (*MultiReply, error) {
+	out := new(MultiReply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/MultiGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) MultiSet(ctx context.Context, in *MultiSetRequest, opts ...grpc.CallOption) (*MultiReply, error) {
+	out := new(MultiReply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/MultiSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) MultiDelete(ctx context.Context, in *MultiDeleteRequest, opts ...grpc.CallOption) (*MultiReply, error) {
+	out := new(MultiReply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/MultiDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (Drcache_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Drcache_serviceDesc.Streams[0], "/definitions.drcache/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &drcacheWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Drcache_WatchClient interface {
+	Recv() (*WatchEvent, error)
+	grpc.ClientStream
+}
+
+type drcacheWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *drcacheWatchClient) Recv() (*WatchEvent, error) {
+	m := new(WatchEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *drcacheClient) Incr(ctx context.Context, in *CounterRequest, opts ...grpc.CallOption) (*Reply, error) {
+	out := new(Reply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/Incr", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) Decr(ctx context.Context, in *CounterRequest, opts ...grpc.CallOption) (*Reply, error) {
+	out := new(Reply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/Decr", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) Append(ctx context.Context, in *AppendRequest, opts ...grpc.CallOption) (*Reply, error) {
+	out := new(Reply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/Append", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) Prepend(ctx context.Context, in *AppendRequest, opts ...grpc.CallOption) (*Reply, error) {
+	out := new(Reply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/Prepend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) Touch(ctx context.Context, in *TouchRequest, opts ...grpc.CallOption) (*Reply, error) {
+	out := new(Reply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/Touch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) GetAndTouch(ctx context.Context, in *TouchRequest, opts ...grpc.CallOption) (*Reply, error) {
+	out := new(Reply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/GetAndTouch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) Scan(ctx context.Context, in *ScanRequest, opts ...grpc.CallOption) (*ScanReply, error) {
+	out := new(ScanReply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/Scan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) CreateNamespace(ctx context.Context, in *NamespaceRequest, opts ...grpc.CallOption) (*Reply, error) {
+	out := new(Reply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/CreateNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) DescribeNamespace(ctx context.Context, in *NamespaceRequest, opts ...grpc.CallOption) (*NamespaceStats, error) {
+	out := new(NamespaceStats)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/DescribeNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) InvalidateTag(ctx context.Context, in *InvalidateTagRequest, opts ...grpc.CallOption) (*ClusterReply, error) {
+	out := new(ClusterReply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/InvalidateTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) Stats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*StatsReply, error) {
+	out := new(StatsReply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/Stats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) HSet(ctx context.Context, in *HashSetRequest, opts ...grpc.CallOption) (*HashReply, error) {
+	out := new(HashReply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/HSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) HGet(ctx context.Context, in *HashGetRequest, opts ...grpc.CallOption) (*HashReply, error) {
+	out := new(HashReply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/HGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) HDel(ctx context.Context, in *HashGetRequest, opts ...grpc.CallOption) (*HashReply, error) {
+	out := new(HashReply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/HDel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) HIncr(ctx context.Context, in *HashIncrRequest, opts ...grpc.CallOption) (*HashReply, error) {
+	out := new(HashReply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/HIncr", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) HGetAll(ctx context.Context, in *HashGetRequest, opts ...grpc.CallOption) (*HashReply, error) {
+	out := new(HashReply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/HGetAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) LPush(ctx context.Context, in *ListPushRequest, opts ...grpc.CallOption) (*ListReply, error) {
+	out := new(ListReply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/LPush", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) RPush(ctx context.Context, in *ListPushRequest, opts ...grpc.CallOption) (*ListReply, error) {
+	out := new(ListReply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/RPush", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) LPop(ctx context.Context, in *ListPopRequest, opts ...grpc.CallOption) (*ListReply, error) {
+	out := new(ListReply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/LPop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) RPop(ctx context.Context, in *ListPopRequest, opts ...grpc.CallOption) (*ListReply, error) {
+	out := new(ListReply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/RPop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) BLPop(ctx context.Context, in *ListPopRequest, opts ...grpc.CallOption) (*ListReply, error) {
+	out := new(ListReply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/BLPop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) BRPop(ctx context.Context, in *ListPopRequest, opts ...grpc.CallOption) (*ListReply, error) {
+	out := new(ListReply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/BRPop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) LRange(ctx context.Context, in *ListRangeRequest, opts ...grpc.CallOption) (*ListReply, error) {
+	out := new(ListReply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/LRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) ZAdd(ctx context.Context, in *SortedSetAddRequest, opts ...grpc.CallOption) (*SortedSetReply, error) {
+	out := new(SortedSetReply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/ZAdd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) ZIncrBy(ctx context.Context, in *SortedSetIncrRequest, opts ...grpc.CallOption) (*SortedSetReply, error) {
+	out := new(SortedSetReply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/ZIncrBy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) ZRange(ctx context.Context, in *SortedSetRangeRequest, opts ...grpc.CallOption) (*SortedSetReply, error) {
+	out := new(SortedSetReply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/ZRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) ZRangeByScore(ctx context.Context, in *SortedSetScoreRequest, opts ...grpc.CallOption) (*SortedSetReply, error) {
+	out := new(SortedSetReply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/ZRangeByScore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) ZRemRangeByRank(ctx context.Context, in *SortedSetRangeRequest, opts ...grpc.CallOption) (*SortedSetReply, error) {
+	out := new(SortedSetReply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/ZRemRangeByRank", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) ZRemRangeByScore(ctx context.Context, in *SortedSetScoreRequest, opts ...grpc.CallOption) (*SortedSetReply, error) {
+	out := new(SortedSetReply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/ZRemRangeByScore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) Publish(ctx context.Context, in *PublishRequest, opts ...grpc.CallOption) (*PublishReply, error) {
+	out := new(PublishReply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/Publish", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Drcache_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Drcache_serviceDesc.Streams[1], "/definitions.drcache/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &drcacheSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Drcache_SubscribeClient interface {
+	Recv() (*Message, error)
+	grpc.ClientStream
+}
+
+type drcacheSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *drcacheSubscribeClient) Recv() (*Message, error) {
+	m := new(Message)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *drcacheClient) Lock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*LockReply, error) {
+	out := new(LockReply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/Lock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) Unlock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*LockReply, error) {
+	out := new(LockReply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/Unlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) RefreshLock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*LockReply, error) {
+	out := new(LockReply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/RefreshLock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) RateLimit(ctx context.Context, in *RateLimitRequest, opts ...grpc.CallOption) (*RateLimitReply, error) {
+	out := new(RateLimitReply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/RateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) PFAdd(ctx context.Context, in *HyperLogLogAddRequest, opts ...grpc.CallOption) (*HyperLogLogReply, error) {
+	out := new(HyperLogLogReply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/PFAdd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) PFCount(ctx context.Context, in *HyperLogLogCountRequest, opts ...grpc.CallOption) (*HyperLogLogReply, error) {
+	out := new(HyperLogLogReply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/PFCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) PFMerge(ctx context.Context, in *HyperLogLogMergeRequest, opts ...grpc.CallOption) (*HyperLogLogReply, error) {
+	out := new(HyperLogLogReply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/PFMerge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) BFCreate(ctx context.Context, in *BloomCreateRequest, opts ...grpc.CallOption) (*BloomReply, error) {
+	out := new(BloomReply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/BFCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) BFAdd(ctx context.Context, in *BloomRequest, opts ...grpc.CallOption) (*BloomReply, error) {
+	out := new(BloomReply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/BFAdd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) BFExists(ctx context.Context, in *BloomRequest, opts ...grpc.CallOption) (*BloomReply, error) {
+	out := new(BloomReply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/BFExists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingReply, error) {
+	out := new(PingReply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/Ping", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drcacheClient) PingReq(ctx context.Context, in *PingReqRequest, opts ...grpc.CallOption) (*PingReply, error) {
+	out := new(PingReply)
+	err := c.cc.Invoke(ctx, "/definitions.drcache/PingReq", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DrcacheServer is the server API for Drcache service.
+type DrcacheServer interface {
+	Add(context.Context, *AddRequest) (*Reply, error)
+	Set(context.Context, *SetRequest) (*Reply, error)
+	Delete(context.Context, *DeleteRequest) (*Reply, error)
+	DeleteAll(context.Context, *DeleteAllRequest) (*ClusterReply, error)
+	Get(context.Context, *GetRequest) (*Reply, error)
+	AddServer(context.Context, *AddServerRequest) (*Reply, error)
+	GetServers(context.Context, *GetServersRequest) (*ServerList, error)
+	DropServer(context.Context, *DropServerRequest) (*Reply, error)
+	HandOff(context.Context, *HandOffRequest) (*Reply, error)
+	CompareAndSwap(context.Context, *CompareAndSwapRequest) (*Reply, error)
+	MultiGet(context.Context, *MultiGetRequest) (*MultiReply, error)
+	MultiSet(context.Context, *MultiSetRequest) (*MultiReply, error)
+	MultiDelete(context.Context, *MultiDeleteRequest) (*MultiReply, error)
+	Watch(*WatchRequest, Drcache_WatchServer) error
+	Incr(context.Context, *CounterRequest) (*Reply, error)
+	Decr(context.Context, *CounterRequest) (*Reply, error)
+	Append(context.Context, *AppendRequest) (*Reply, error)
+	Prepend(context.Context, *AppendRequest) (*Reply, error)
+	Touch(context.Context, *TouchRequest) (*Reply, error)
+	GetAndTouch(context.Context, *TouchRequest) (*Reply, error)
+	Scan(context.Context, *ScanRequest) (*ScanReply, error)
+	CreateNamespace(context.Context, *NamespaceRequest) (*Reply, error)
+	DescribeNamespace(context.Context, *NamespaceRequest) (*NamespaceStats, error)
+	InvalidateTag(context.Context, *InvalidateTagRequest) (*ClusterReply, error)
+	Stats(context.Context, *StatsRequest) (*StatsReply, error)
+	HSet(context.Context, *HashSetRequest) (*HashReply, error)
+	HGet(context.Context, *HashGetRequest) (*HashReply, error)
+	HDel(context.Context, *HashGetRequest) (*HashReply, error)
+	HIncr(context.Context, *HashIncrRequest) (*HashReply, error)
+	HGetAll(context.Context, *HashGetRequest) (*HashReply, error)
+	LPush(context.Context, *ListPushRequest) (*ListReply, error)
+	RPush(context.Context, *ListPushRequest) (*ListReply, error)
+	LPop(context.Context, *ListPopRequest) (*ListReply, error)
+	RPop(context.Context, *ListPopRequest) (*ListReply, error)
+	BLPop(context.Context, *ListPopRequest) (*ListReply, error)
+	BRPop(context.Context, *ListPopRequest) (*ListReply, error)
+	LRange(context.Context, *ListRangeRequest) (*ListReply, error)
+	ZAdd(context.Context, *SortedSetAddRequest) (*SortedSetReply, error)
+	ZIncrBy(context.Context, *SortedSetIncrRequest) (*SortedSetReply, error)
+	ZRange(context.Context, *SortedSetRangeRequest) (*SortedSetReply, error)
+	ZRangeByScore(context.Context, *SortedSetScoreRequest) (*SortedSetReply, error)
+	ZRemRangeByRank(context.Context, *SortedSetRangeRequest) (*SortedSetReply, error)
+	ZRemRangeByScore(context.Context, *SortedSetScoreRequest) (*SortedSetReply, error)
+	Publish(context.Context, *PublishRequest) (*PublishReply, error)
+	Subscribe(*SubscribeRequest, Drcache_SubscribeServer) error
+	Lock(context.Context, *LockRequest) (*LockReply, error)
+	Unlock(context.Context, *LockRequest) (*LockReply, error)
+	RefreshLock(context.Context, *LockRequest) (*LockReply, error)
+	RateLimit(context.Context, *RateLimitRequest) (*RateLimitReply, error)
+	PFAdd(context.Context, *HyperLogLogAddRequest) (*HyperLogLogReply, error)
+	PFCount(context.Context, *HyperLogLogCountRequest) (*HyperLogLogReply, error)
+	PFMerge(context.Context, *HyperLogLogMergeRequest) (*HyperLogLogReply, error)
+	BFCreate(context.Context, *BloomCreateRequest) (*BloomReply, error)
+	BFAdd(context.Context, *BloomRequest) (*BloomReply, error)
+	BFExists(context.Context, *BloomRequest) (*BloomReply, error)
+	Ping(context.Context, *PingRequest) (*PingReply, error)
+	PingReq(context.Context, *PingReqRequest) (*PingReply, error)
+}
+
+// UnimplementedDrcacheServer can be embedded to have forward compatible implementations.
+type UnimplementedDrcacheServer struct {
+}
+
+func (*UnimplementedDrcacheServer) Add(ctx context.Context, req *AddRequest) (*Reply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Add not implemented")
+}
+func (*UnimplementedDrcacheServer) Set(ctx context.Context, req *SetRequest) (*Reply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Set not implemented")
+}
+func (*UnimplementedDrcacheServer) Delete(ctx context.Context, req *DeleteRequest) (*Reply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (*UnimplementedDrcacheServer) DeleteAll(ctx context.Context, req *DeleteAllRequest) (*ClusterReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAll not implemented")
+}
+func (*UnimplementedDrcacheServer) Get(ctx context.Context, req *GetRequest) (*Reply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (*UnimplementedDrcacheServer) AddServer(ctx context.Context, req *AddServerRequest) (*Reply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddServer not implemented")
+}
+func (*UnimplementedDrcacheServer) GetServers(ctx context.Context, req *GetServersRequest) (*ServerList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServers not implemented")
+}
+func (*UnimplementedDrcacheServer) DropServer(ctx context.Context, req *DropServerRequest) (*Reply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropServer not implemented")
+}
+func (*UnimplementedDrcacheServer) HandOff(ctx context.Context, req *HandOffRequest) (*Reply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandOff not implemented")
+}
+func (*UnimplementedDrcacheServer) CompareAndSwap(ctx context.Context, req *CompareAndSwapRequest) (*Reply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareAndSwap not implemented")
+}
+func (*UnimplementedDrcacheServer) MultiGet(ctx context.Context, req *MultiGetRequest) (*MultiReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiGet not implemented")
+}
+func (*UnimplementedDrcacheServer) MultiSet(ctx context.Context, req *MultiSetRequest) (*MultiReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiSet not implemented")
+}
+func (*UnimplementedDrcacheServer) MultiDelete(ctx context.Context, req *MultiDeleteRequest) (*MultiReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiDelete not implemented")
+}
+func (*UnimplementedDrcacheServer) Watch(req *WatchRequest, srv Drcache_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+func (*UnimplementedDrcacheServer) Incr(ctx context.Context, req *CounterRequest) (*Reply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Incr not implemented")
+}
+func (*UnimplementedDrcacheServer) Decr(ctx context.Context, req *CounterRequest) (*Reply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Decr not implemented")
+}
+func (*UnimplementedDrcacheServer) Append(ctx context.Context, req *AppendRequest) (*Reply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Append not implemented")
+}
+func (*UnimplementedDrcacheServer) Prepend(ctx context.Context, req *AppendRequest) (*Reply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Prepend not implemented")
+}
+func (*UnimplementedDrcacheServer) Touch(ctx context.Context, req *TouchRequest) (*Reply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Touch not implemented")
+}
+func (*UnimplementedDrcacheServer) GetAndTouch(ctx context.Context, req *TouchRequest) (*Reply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAndTouch not implemented")
+}
+func (*UnimplementedDrcacheServer) Scan(ctx context.Context, req *ScanRequest) (*ScanReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Scan not implemented")
+}
+func (*UnimplementedDrcacheServer) CreateNamespace(ctx context.Context, req *NamespaceRequest) (*Reply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateNamespace not implemented")
+}
+func (*UnimplementedDrcacheServer) DescribeNamespace(ctx context.Context, req *NamespaceRequest) (*NamespaceStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeNamespace not implemented")
+}
+func (*UnimplementedDrcacheServer) InvalidateTag(ctx context.Context, req *InvalidateTagRequest) (*ClusterReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvalidateTag not implemented")
+}
+func (*UnimplementedDrcacheServer) Stats(ctx context.Context, req *StatsRequest) (*StatsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stats not implemented")
+}
+func (*UnimplementedDrcacheServer) HSet(ctx context.Context, req *HashSetRequest) (*HashReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HSet not implemented")
+}
+func (*UnimplementedDrcacheServer) HGet(ctx context.Context, req *HashGetRequest) (*HashReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HGet not implemented")
+}
+func (*UnimplementedDrcacheServer) HDel(ctx context.Context, req *HashGetRequest) (*HashReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HDel not implemented")
+}
+func (*UnimplementedDrcacheServer) HIncr(ctx context.Context, req *HashIncrRequest) (*HashReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HIncr not implemented")
+}
+func (*UnimplementedDrcacheServer) HGetAll(ctx context.Context, req *HashGetRequest) (*HashReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HGetAll not implemented")
+}
+func (*UnimplementedDrcacheServer) LPush(ctx context.Context, req *ListPushRequest) (*ListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LPush not implemented")
+}
+func (*UnimplementedDrcacheServer) RPush(ctx context.Context, req *ListPushRequest) (*ListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RPush not implemented")
+}
+func (*UnimplementedDrcacheServer) LPop(ctx context.Context, req *ListPopRequest) (*ListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LPop not implemented")
+}
+func (*UnimplementedDrcacheServer) RPop(ctx context.Context, req *ListPopRequest) (*ListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RPop not implemented")
+}
+func (*UnimplementedDrcacheServer) BLPop(ctx context.Context, req *ListPopRequest) (*ListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BLPop not implemented")
+}
+func (*UnimplementedDrcacheServer) BRPop(ctx context.Context, req *ListPopRequest) (*ListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BRPop not implemented")
+}
+func (*UnimplementedDrcacheServer) LRange(ctx context.Context, req *ListRangeRequest) (*ListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LRange not implemented")
+}
+func (*UnimplementedDrcacheServer) ZAdd(ctx context.Context, req *SortedSetAddRequest) (*SortedSetReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZAdd not implemented")
+}
+func (*UnimplementedDrcacheServer) ZIncrBy(ctx context.Context, req *SortedSetIncrRequest) (*SortedSetReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZIncrBy not implemented")
+}
+func (*UnimplementedDrcacheServer) ZRange(ctx context.Context, req *SortedSetRangeRequest) (*SortedSetReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZRange not implemented")
+}
+func (*UnimplementedDrcacheServer) ZRangeByScore(ctx context.Context, req *SortedSetScoreRequest) (*SortedSetReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZRangeByScore not implemented")
+}
+func (*UnimplementedDrcacheServer) ZRemRangeByRank(ctx context.Context, req *SortedSetRangeRequest) (*SortedSetReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZRemRangeByRank not implemented")
+}
+func (*UnimplementedDrcacheServer) ZRemRangeByScore(ctx context.Context, req *SortedSetScoreRequest) (*SortedSetReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZRemRangeByScore not implemented")
+}
+func (*UnimplementedDrcacheServer) Publish(ctx context.Context, req *PublishRequest) (*PublishReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Publish not implemented")
+}
+func (*UnimplementedDrcacheServer) Subscribe(req *SubscribeRequest, srv Drcache_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (*UnimplementedDrcacheServer) Lock(ctx context.Context, req *LockRequest) (*LockReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lock not implemented")
+}
+func (*UnimplementedDrcacheServer) Unlock(ctx context.Context, req *LockRequest) (*LockReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unlock not implemented")
+}
+func (*UnimplementedDrcacheServer) RefreshLock(ctx context.Context, req *LockRequest) (*LockReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshLock not implemented")
+}
+func (*UnimplementedDrcacheServer) RateLimit(ctx context.Context, req *RateLimitRequest) (*RateLimitReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimit not implemented")
+}
+func (*UnimplementedDrcacheServer) PFAdd(ctx context.Context, req *HyperLogLogAddRequest) (*HyperLogLogReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PFAdd not implemented")
+}
+func (*UnimplementedDrcacheServer) PFCount(ctx context.Context, req *HyperLogLogCountRequest) (*HyperLogLogReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PFCount not implemented")
+}
+func (*UnimplementedDrcacheServer) PFMerge(ctx context.Context, req *HyperLogLogMergeRequest) (*HyperLogLogReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PFMerge not implemented")
+}
+func (*UnimplementedDrcacheServer) BFCreate(ctx context.Context, req *BloomCreateRequest) (*BloomReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BFCreate not implemented")
+}
+func (*UnimplementedDrcacheServer) BFAdd(ctx context.Context, req *BloomRequest) (*BloomReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BFAdd not implemented")
+}
+func (*UnimplementedDrcacheServer) BFExists(ctx context.Context, req *BloomRequest) (*BloomReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BFExists not implemented")
+}
+func (*UnimplementedDrcacheServer) Ping(ctx context.Context, req *PingRequest) (*PingReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+}
+func (*UnimplementedDrcacheServer) PingReq(ctx context.Context, req *PingReqRequest) (*PingReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PingReq not implemented")
+}
+
+func RegisterDrcacheServer(s *grpc.Server, srv DrcacheServer) {
+	s.RegisterService(&_Drcache_serviceDesc, srv)
+}
+
+func _Drcache_Add_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrcacheServer).Add(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/definitions.drcache/Add",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrcacheServer).Add(ctx, req.(*AddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Drcache_Set_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrcacheServer).Set(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/definitions.drcache/Set",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrcacheServer).Set(ctx, req.(*SetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Drcache_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrcacheServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/definitions.drcache/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrcacheServer).Delete(ctx, req.(*DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Drcache_DeleteAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrcacheServer).DeleteAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/definitions.drcache/DeleteAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrcacheServer).DeleteAll(ctx, req.(*DeleteAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Drcache_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrcacheServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/definitions.drcache/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrcacheServer).Get(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Drcache_AddServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrcacheServer).AddServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/definitions.drcache/AddServer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrcacheServer).AddServer(ctx, req.(*AddServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Drcache_GetServers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrcacheServer).GetServers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/definitions.drcache/GetServers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrcacheServer).GetServers(ctx, req.(*GetServersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Drcache_DropServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrcacheServer).DropServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/definitions.drcache/DropServer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrcacheServer).DropServer(ctx, req.(*DropServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Drcache_HandOff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandOffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrcacheServer).HandOff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/definitions.drcache/HandOff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrcacheServer).HandOff(ctx, req.(*HandOffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Drcache_CompareAndSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareAndSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrcacheServer).CompareAndSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/definitions.drcache/CompareAndSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrcacheServer).CompareAndSwap(ctx, req.(*CompareAndSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Drcache_MultiGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrcacheServer).MultiGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/definitions.drcache/MultiGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrcacheServer).MultiGet(ctx, req.(*MultiGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Drcache_MultiSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrcacheServer).MultiSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/definitions.drcache/MultiSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrcacheServer).MultiSet(ctx, req.(*MultiSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Drcache_MultiDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrcacheServer).MultiDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/definitions.drcache/MultiDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrcacheServer).MultiDelete(ctx, req.(*MultiDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Drcache_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DrcacheServer).Watch(m, &drcacheWatchServer{stream})
+}
+
+type Drcache_WatchServer interface {
+	Send(*WatchEvent) error
+	grpc.ServerStream
+}
+
+type drcacheWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *drcacheWatchServer) Send(m *WatchEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Drcache_Incr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CounterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrcacheServer).Incr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/definitions.drcache/Incr",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrcacheServer).Incr(ctx, req.(*CounterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Drcache_Decr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CounterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrcacheServer).Decr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/definitions.drcache/Decr",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrcacheServer).Decr(ctx, req.(*CounterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Drcache_Append_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrcacheServer).Append(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/definitions.drcache/Append",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrcacheServer).Append(ctx, req.(*AppendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Drcache_Prepend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrcacheServer).Prepend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/definitions.drcache/Prepend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrcacheServer).Prepend(ctx, req.(*AppendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Drcache_Touch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TouchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrcacheServer).Touch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/definitions.drcache/Touch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrcacheServer).Touch(ctx, req.(*TouchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Drcache_GetAndTouch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TouchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrcacheServer).GetAndTouch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/definitions.drcache/GetAndTouch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrcacheServer).GetAndTouch(ctx, req.(*TouchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Drcache_Scan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrcacheServer).Scan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/definitions.drcache/Scan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrcacheServer).Scan(ctx, req.(*ScanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Drcache_CreateNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrcacheServer).CreateNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/definitions.drcache/CreateNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrcacheServer).CreateNamespace(ctx, req.(*NamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Drcache_DescribeNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrcacheServer).DescribeNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/definitions.drcache/DescribeNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrcacheServer).DescribeNamespace(ctx, req.(*NamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Drcache_InvalidateTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvalidateTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrcacheServer).InvalidateTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/definitions.drcache/InvalidateTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrcacheServer).InvalidateTag(ctx, req.(*InvalidateTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Drcache_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrcacheServer).Stats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/definitions.drcache/Stats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrcacheServer).Stats(ctx, req.(*StatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Drcache_HSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HashSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrcacheServer).HSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/definitions.drcache/HSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrcacheServer).HSet(ctx, req.(*HashSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Drcache_HGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HashGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrcacheServer).HGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/definitions.drcache/HGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrcacheServer).HGet(ctx, req.(*HashGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Drcache_HDel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HashGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrcacheServer).HDel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/definitions.drcache/HDel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrcacheServer).HDel(ctx, req.(*HashGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Drcache_HIncr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HashIncrRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrcacheServer).HIncr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/definitions.drcache/HIncr",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrcacheServer).HIncr(ctx, req.(*HashIncrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Drcache_HGetAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HashGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrcacheServer).HGetAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/definitions.drcache/HGetAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrcacheServer).HGetAll(ctx, req.(*HashGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Drcache_LPush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPushRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrcacheServer).LPush(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/definitions.drcache/LPush",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrcacheServer).LPush(ctx, req.(*ListPushRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Drcache_RPush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPushRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrcacheServer).RPush(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/definitions.drcache/RPush",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrcacheServer).RPush(ctx, req.(*ListPushRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Drcache_LPop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPopRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrcacheServer).LPop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/definitions.drcache/LPop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrcacheServer).LPop(ctx, req.(*ListPopRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Drcache_RPop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPopRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrcacheServer).RPop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/definitions.drcache/RPop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrcacheServer).RPop(ctx, req.(*ListPopRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Drcache_BLPop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPopRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrcacheServer).BLPop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/definitions.drcache/BLPop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrcacheServer).BLPop(ctx, req.(*ListPopRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Drcache_BRPop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPopRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrcacheServer).BRPop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/definitions.drcache/BRPop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrcacheServer).BRPop(ctx, req.(*ListPopRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Drcache_LRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrcacheServer).LRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/definitions.drcache/LRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrcacheServer).LRange(ctx, req.(*ListRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Drcache_ZAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SortedSetAddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrcacheServer).ZAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/definitions.drcache/ZAdd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrcacheServer).ZAdd(ctx, req.(*SortedSetAddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Drcache_ZIncrBy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SortedSetIncrRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrcacheServer).ZIncrBy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/definitions.drcache/ZIncrBy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrcacheServer).ZIncrBy(ctx, req.(*SortedSetIncrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Drcache_ZRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SortedSetRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrcacheServer).ZRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/definitions.drcache/ZRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrcacheServer).ZRange(ctx, req.(*SortedSetRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Drcache_ZRangeByScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SortedSetScoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrcacheServer).ZRangeByScore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/definitions.drcache/ZRangeByScore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrcacheServer).ZRangeByScore(ctx, req.(*SortedSetScoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Drcache_ZRemRangeByRank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SortedSetRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrcacheServer).ZRemRangeByRank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/definitions.drcache/ZRemRangeByRank",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrcacheServer).ZRemRangeByRank(ctx, req.(*SortedSetRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *drcacheClient) DropServer(ctx context.Context, in *DropServerRequest, opts ...grpc.CallOption) (*Reply, error) {
-	out := new(Reply)
-	err := c.cc.Invoke(ctx, "/definitions.drcache/DropServer", in, out, opts...)
-	if err != nil {
+func _Drcache_ZRemRangeByScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SortedSetScoreRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(DrcacheServer).ZRemRangeByScore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/definitions.drcache/ZRemRangeByScore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrcacheServer).ZRemRangeByScore(ctx, req.(*SortedSetScoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// DrcacheServer is the server API for Drcache service.
-type DrcacheServer interface {
-	Add(context.Context, *AddRequest) (*Reply, error)
-	Set(context.Context, *SetRequest) (*Reply, error)
-	Delete(context.Context, *DeleteRequest) (*Reply, error)
-	DeleteAll(context.Context, *DeleteAllRequest) (*Reply, error)
-	Get(context.Context, *GetRequest) (*Reply, error)
-	AddServer(context.Context, *AddServerRequest) (*Reply, error)
-	GetServers(context.Context, *GetServersRequest) (*ServerList, error)
-	DropServer(context.Context, *DropServerRequest) (*Reply, error)
+func _Drcache_Publish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrcacheServer).Publish(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/definitions.drcache/Publish",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrcacheServer).Publish(ctx, req.(*PublishRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedDrcacheServer can be embedded to have forward compatible implementations.
-type UnimplementedDrcacheServer struct {
+func _Drcache_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DrcacheServer).Subscribe(m, &drcacheSubscribeServer{stream})
 }
 
-func (*UnimplementedDrcacheServer) Add(ctx context.Context, req *AddRequest) (*Reply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Add not implemented")
-}
-func (*UnimplementedDrcacheServer) Set(ctx context.Context, req *SetRequest) (*Reply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Set not implemented")
-}
-func (*UnimplementedDrcacheServer) Delete(ctx context.Context, req *DeleteRequest) (*Reply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+type Drcache_SubscribeServer interface {
+	Send(*Message) error
+	grpc.ServerStream
 }
-func (*UnimplementedDrcacheServer) DeleteAll(ctx context.Context, req *DeleteAllRequest) (*Reply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteAll not implemented")
+
+type drcacheSubscribeServer struct {
+	grpc.ServerStream
 }
-func (*UnimplementedDrcacheServer) Get(ctx context.Context, req *GetRequest) (*Reply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+
+func (x *drcacheSubscribeServer) Send(m *Message) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (*UnimplementedDrcacheServer) AddServer(ctx context.Context, req *AddServerRequest) (*Reply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddServer not implemented")
+
+func _Drcache_Lock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrcacheServer).Lock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/definitions.drcache/Lock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrcacheServer).Lock(ctx, req.(*LockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedDrcacheServer) GetServers(ctx context.Context, req *GetServersRequest) (*ServerList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetServers not implemented")
+
+func _Drcache_Unlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrcacheServer).Unlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/definitions.drcache/Unlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrcacheServer).Unlock(ctx, req.(*LockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedDrcacheServer) DropServer(ctx context.Context, req *DropServerRequest) (*Reply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DropServer not implemented")
+
+func _Drcache_RefreshLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrcacheServer).RefreshLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/definitions.drcache/RefreshLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrcacheServer).RefreshLock(ctx, req.(*LockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterDrcacheServer(s *grpc.Server, srv DrcacheServer) {
-	s.RegisterService(&_Drcache_serviceDesc, srv)
+func _Drcache_RateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrcacheServer).RateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/definitions.drcache/RateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrcacheServer).RateLimit(ctx, req.(*RateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Drcache_Add_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddRequest)
+func _Drcache_PFAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HyperLogLogAddRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DrcacheServer).Add(ctx, in)
+		return srv.(DrcacheServer).PFAdd(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/definitions.drcache/Add",
+		FullMethod: "/definitions.drcache/PFAdd",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DrcacheServer).Add(ctx, req.(*AddRequest))
+		return srv.(DrcacheServer).PFAdd(ctx, req.(*HyperLogLogAddRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Drcache_Set_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetRequest)
+func _Drcache_PFCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HyperLogLogCountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DrcacheServer).Set(ctx, in)
+		return srv.(DrcacheServer).PFCount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/definitions.drcache/Set",
+		FullMethod: "/definitions.drcache/PFCount",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DrcacheServer).Set(ctx, req.(*SetRequest))
+		return srv.(DrcacheServer).PFCount(ctx, req.(*HyperLogLogCountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Drcache_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteRequest)
+func _Drcache_PFMerge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HyperLogLogMergeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DrcacheServer).Delete(ctx, in)
+		return srv.(DrcacheServer).PFMerge(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/definitions.drcache/Delete",
+		FullMethod: "/definitions.drcache/PFMerge",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DrcacheServer).Delete(ctx, req.(*DeleteRequest))
+		return srv.(DrcacheServer).PFMerge(ctx, req.(*HyperLogLogMergeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Drcache_DeleteAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteAllRequest)
+func _Drcache_BFCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BloomCreateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DrcacheServer).DeleteAll(ctx, in)
+		return srv.(DrcacheServer).BFCreate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/definitions.drcache/DeleteAll",
+		FullMethod: "/definitions.drcache/BFCreate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DrcacheServer).DeleteAll(ctx, req.(*DeleteAllRequest))
+		return srv.(DrcacheServer).BFCreate(ctx, req.(*BloomCreateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Drcache_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRequest)
+func _Drcache_BFAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BloomRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DrcacheServer).Get(ctx, in)
+		return srv.(DrcacheServer).BFAdd(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/definitions.drcache/Get",
+		FullMethod: "/definitions.drcache/BFAdd",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DrcacheServer).Get(ctx, req.(*GetRequest))
+		return srv.(DrcacheServer).BFAdd(ctx, req.(*BloomRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Drcache_AddServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddServerRequest)
+func _Drcache_BFExists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BloomRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DrcacheServer).AddServer(ctx, in)
+		return srv.(DrcacheServer).BFExists(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/definitions.drcache/AddServer",
+		FullMethod: "/definitions.drcache/BFExists",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DrcacheServer).AddServer(ctx, req.(*AddServerRequest))
+		return srv.(DrcacheServer).BFExists(ctx, req.(*BloomRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Drcache_GetServers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetServersRequest)
+func _Drcache_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DrcacheServer).GetServers(ctx, in)
+		return srv.(DrcacheServer).Ping(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/definitions.drcache/GetServers",
+		FullMethod: "/definitions.drcache/Ping",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DrcacheServer).GetServers(ctx, req.(*GetServersRequest))
+		return srv.(DrcacheServer).Ping(ctx, req.(*PingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Drcache_DropServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DropServerRequest)
+func _Drcache_PingReq_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PingReqRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DrcacheServer).DropServer(ctx, in)
+		return srv.(DrcacheServer).PingReq(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/definitions.drcache/DropServer",
+		FullMethod: "/definitions.drcache/PingReq",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DrcacheServer).DropServer(ctx, req.(*DropServerRequest))
+		return srv.(DrcacheServer).PingReq(ctx, req.(*PingReqRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -884,7 +6167,206 @@ var _Drcache_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DropServer",
 			Handler:    _Drcache_DropServer_Handler,
 		},
+		{
+			MethodName: "HandOff",
+			Handler:    _Drcache_HandOff_Handler,
+		},
+		{
+			MethodName: "CompareAndSwap",
+			Handler:    _Drcache_CompareAndSwap_Handler,
+		},
+		{
+			MethodName: "MultiGet",
+			Handler:    _Drcache_MultiGet_Handler,
+		},
+		{
+			MethodName: "MultiSet",
+			Handler:    _Drcache_MultiSet_Handler,
+		},
+		{
+			MethodName: "MultiDelete",
+			Handler:    _Drcache_MultiDelete_Handler,
+		},
+		{
+			MethodName: "Incr",
+			Handler:    _Drcache_Incr_Handler,
+		},
+		{
+			MethodName: "Decr",
+			Handler:    _Drcache_Decr_Handler,
+		},
+		{
+			MethodName: "Append",
+			Handler:    _Drcache_Append_Handler,
+		},
+		{
+			MethodName: "Prepend",
+			Handler:    _Drcache_Prepend_Handler,
+		},
+		{
+			MethodName: "Touch",
+			Handler:    _Drcache_Touch_Handler,
+		},
+		{
+			MethodName: "GetAndTouch",
+			Handler:    _Drcache_GetAndTouch_Handler,
+		},
+		{
+			MethodName: "Scan",
+			Handler:    _Drcache_Scan_Handler,
+		},
+		{
+			MethodName: "CreateNamespace",
+			Handler:    _Drcache_CreateNamespace_Handler,
+		},
+		{
+			MethodName: "DescribeNamespace",
+			Handler:    _Drcache_DescribeNamespace_Handler,
+		},
+		{
+			MethodName: "InvalidateTag",
+			Handler:    _Drcache_InvalidateTag_Handler,
+		},
+		{
+			MethodName: "Stats",
+			Handler:    _Drcache_Stats_Handler,
+		},
+		{
+			MethodName: "HSet",
+			Handler:    _Drcache_HSet_Handler,
+		},
+		{
+			MethodName: "HGet",
+			Handler:    _Drcache_HGet_Handler,
+		},
+		{
+			MethodName: "HDel",
+			Handler:    _Drcache_HDel_Handler,
+		},
+		{
+			MethodName: "HIncr",
+			Handler:    _Drcache_HIncr_Handler,
+		},
+		{
+			MethodName: "HGetAll",
+			Handler:    _Drcache_HGetAll_Handler,
+		},
+		{
+			MethodName: "LPush",
+			Handler:    _Drcache_LPush_Handler,
+		},
+		{
+			MethodName: "RPush",
+			Handler:    _Drcache_RPush_Handler,
+		},
+		{
+			MethodName: "LPop",
+			Handler:    _Drcache_LPop_Handler,
+		},
+		{
+			MethodName: "RPop",
+			Handler:    _Drcache_RPop_Handler,
+		},
+		{
+			MethodName: "BLPop",
+			Handler:    _Drcache_BLPop_Handler,
+		},
+		{
+			MethodName: "BRPop",
+			Handler:    _Drcache_BRPop_Handler,
+		},
+		{
+			MethodName: "LRange",
+			Handler:    _Drcache_LRange_Handler,
+		},
+		{
+			MethodName: "ZAdd",
+			Handler:    _Drcache_ZAdd_Handler,
+		},
+		{
+			MethodName: "ZIncrBy",
+			Handler:    _Drcache_ZIncrBy_Handler,
+		},
+		{
+			MethodName: "ZRange",
+			Handler:    _Drcache_ZRange_Handler,
+		},
+		{
+			MethodName: "ZRangeByScore",
+			Handler:    _Drcache_ZRangeByScore_Handler,
+		},
+		{
+			MethodName: "ZRemRangeByRank",
+			Handler:    _Drcache_ZRemRangeByRank_Handler,
+		},
+		{
+			MethodName: "ZRemRangeByScore",
+			Handler:    _Drcache_ZRemRangeByScore_Handler,
+		},
+		{
+			MethodName: "Publish",
+			Handler:    _Drcache_Publish_Handler,
+		},
+		{
+			MethodName: "Lock",
+			Handler:    _Drcache_Lock_Handler,
+		},
+		{
+			MethodName: "Unlock",
+			Handler:    _Drcache_Unlock_Handler,
+		},
+		{
+			MethodName: "RefreshLock",
+			Handler:    _Drcache_RefreshLock_Handler,
+		},
+		{
+			MethodName: "RateLimit",
+			Handler:    _Drcache_RateLimit_Handler,
+		},
+		{
+			MethodName: "PFAdd",
+			Handler:    _Drcache_PFAdd_Handler,
+		},
+		{
+			MethodName: "PFCount",
+			Handler:    _Drcache_PFCount_Handler,
+		},
+		{
+			MethodName: "PFMerge",
+			Handler:    _Drcache_PFMerge_Handler,
+		},
+		{
+			MethodName: "BFCreate",
+			Handler:    _Drcache_BFCreate_Handler,
+		},
+		{
+			MethodName: "BFAdd",
+			Handler:    _Drcache_BFAdd_Handler,
+		},
+		{
+			MethodName: "BFExists",
+			Handler:    _Drcache_BFExists_Handler,
+		},
+		{
+			MethodName: "Ping",
+			Handler:    _Drcache_Ping_Handler,
+		},
+		{
+			MethodName: "PingReq",
+			Handler:    _Drcache_PingReq_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _Drcache_Watch_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Subscribe",
+			Handler:       _Drcache_Subscribe_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "grpc/definitions/definitions.proto",
 }
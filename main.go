@@ -7,22 +7,51 @@ import (
 	"log"
 	"net"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 )
 
-var (
-	allServers = map[string]struct{}{"localhost:50051": {}}
-)
+const stopTimeout = 10 * time.Second
 
 func main() {
 	self := os.Args[1]
+	seeds := os.Args[2:] // members of the cluster to join, none to start a new one
+	// shared by the members of the cluster, it tells their requests apart from those of clients
+	clusterKey := os.Getenv("DRCACHE_CLUSTER_KEY")
+	if clusterKey == "" {
+		log.Fatal("DRCACHE_CLUSTER_KEY must be set to the key shared by the members of the cluster")
+	}
 	lis, err := net.Listen("tcp", self)
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
 	}
-	drcacheServer := src.NewServer(allServers, 3, self)
-	grpcServer := grpc.NewServer()
+	drcacheServer := src.NewServer(map[string]struct{}{self: {}}, 3, self, clusterKey)
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(drcacheServer.GateUnary), grpc.StreamInterceptor(drcacheServer.GateStream))
 	pb.RegisterDrcacheServer(grpcServer, drcacheServer)
 	println("Server is started.")
+	go func() {
+		if err := drcacheServer.Join(seeds); err != nil {
+			log.Fatalf("failed to join: %v", err)
+		}
+	}()
+	go func() {
+		signals := make(chan os.Signal, 1)
+		signal.Notify(signals, syscall.SIGTERM, os.Interrupt)
+		<-signals
+		drcacheServer.Leave()
+		// streams such as Watch and Subscribe only end with their clients, so GracefulStop is bounded
+		stopped := make(chan struct{})
+		go func() {
+			grpcServer.GracefulStop()
+			close(stopped)
+		}()
+		select {
+		case <-stopped:
+		case <-time.After(stopTimeout):
+			grpcServer.Stop()
+		}
+	}()
 	if err := grpcServer.Serve(lis); err != nil {
 		log.Fatalf("failed to serve: %v", err)
 	}
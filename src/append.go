@@ -0,0 +1,64 @@
+package src
+
+import (
+	"context"
+	pb "drcache/grpc/definitions"
+	"google.golang.org/grpc/status"
+	"log"
+)
+
+/* With consistent hashing check if key belongs to you, if so append in local cache. Otherwise send to other server with client
+If entry does not exist, returns NotFound.
+If exists adds the bytes to the end of the entry's value, keeping its remaining expiration.
+*/
+func (s *Server) Append(ctx context.Context, in *pb.AppendRequest) (*pb.Reply, error) {
+	log.Printf("Received: %v", in.Key)
+	nodeAddress := s.ring().Get(in.Key)
+	if nodeAddress == s.selfAddress {
+		return s.concatLocal(in.Namespace, in.Key, func(value []byte) []byte {
+			return append(value, in.Value...)
+		})
+	} else {
+		reply, err := s.client.AppendItem(nodeAddress, in)
+		if status.Code(err) == 14 { // Connection Error server is down
+			s.suspectServer(nodeAddress)
+		}
+		return reply, err
+	}
+}
+
+/* With consistent hashing check if key belongs to you, if so prepend in local cache. Otherwise send to other server with client
+If entry does not exist, returns NotFound.
+If exists adds the bytes to the start of the entry's value, keeping its remaining expiration.
+*/
+func (s *Server) Prepend(ctx context.Context, in *pb.AppendRequest) (*pb.Reply, error) {
+	log.Printf("Received: %v", in.Key)
+	nodeAddress := s.ring().Get(in.Key)
+	if nodeAddress == s.selfAddress {
+		return s.concatLocal(in.Namespace, in.Key, func(value []byte) []byte {
+			return append(append([]byte{}, in.Value...), value...)
+		})
+	} else {
+		reply, err := s.client.PrependItem(nodeAddress, in)
+		if status.Code(err) == 14 { // Connection Error server is down
+			s.suspectServer(nodeAddress)
+		}
+		return reply, err
+	}
+}
+
+func (s *Server) concatLocal(ns string, key string, concat func([]byte) []byte) (*pb.Reply, error) {
+	lock := s.keyLock(key)
+	lock.Lock()
+	defer lock.Unlock()
+	current, err := s.getLocal(ns, key)
+	if err != nil {
+		return nil, err
+	}
+	if current.Kind != pb.Kind_STRING {
+		return nil, errWrongKind
+	}
+	value := concat(current.Value)
+	item, err := s.setLocal(ns, key, pb.Kind_STRING, value, remainingTTL(current.Expiration), current.Tags)
+	return &pb.Reply{Message: "ok", Item: item}, err
+}
@@ -0,0 +1,51 @@
+package src
+
+import (
+	"context"
+	pb "drcache/grpc/definitions"
+	"errors"
+	"testing"
+)
+
+func TestAppendAndPrepend(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	if _, err := s.Append(ctx, &pb.AppendRequest{Key: "log", Value: []byte("a")}); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Append to a missing key returned %v", err)
+	}
+	reply, err := s.Set(ctx, &pb.SetRequest{Item: &pb.Item{Key: "log", Value: []byte("middle"), Expiration: 300, Tags: []string{"logs"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	appended, err := s.Append(ctx, &pb.AppendRequest{Key: "log", Value: []byte("-end")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if appended.Item.LastUpdate <= reply.Item.LastUpdate {
+		t.Errorf("Append kept version %v", appended.Item.LastUpdate)
+	}
+	if _, err := s.Prepend(ctx, &pb.AppendRequest{Key: "log", Value: []byte("start-")}); err != nil {
+		t.Fatal(err)
+	}
+	got, err := s.Get(ctx, &pb.GetRequest{Key: "log"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got.Item.Value) != "start-middle-end" {
+		t.Errorf("value is %q", got.Item.Value)
+	}
+	if got.Item.Expiration == 0 || len(got.Item.Tags) != 1 {
+		t.Errorf("expiration %v and tags %v were not kept", got.Item.Expiration, got.Item.Tags)
+	}
+}
+
+func TestAppendRefusesOtherKinds(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	if _, err := s.RPush(ctx, &pb.ListPushRequest{Key: "list", Values: [][]byte{[]byte("v")}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Append(ctx, &pb.AppendRequest{Key: "list", Value: []byte("x")}); !errors.Is(err, errWrongKind) {
+		t.Errorf("Append to a list returned %v", err)
+	}
+}
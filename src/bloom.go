@@ -0,0 +1,171 @@
+package src
+
+import (
+	"context"
+	pb "drcache/grpc/definitions"
+	"encoding/binary"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log"
+	"math"
+)
+
+var errInvalidBloom = &Error{codes.InvalidArgument, "INVALID_BLOOM", "Capacity must be positive and error rate between 0 and 1."}
+
+const bloomHeaderSize = 12
+
+/* With consistent hashing check if key belongs to you, if so create the filter in local cache. Otherwise send to other server with client
+Sizes the filter for capacity elements at the error rate. Returns ErrAlreadyExists if the key exists.
+*/
+func (s *Server) BFCreate(ctx context.Context, in *pb.BloomCreateRequest) (*pb.BloomReply, error) {
+	log.Printf("Received: %v", in.Key)
+	nodeAddress := s.ring().Get(in.Key)
+	if nodeAddress == s.selfAddress {
+		if in.Capacity == 0 || !(in.ErrorRate > 0 && in.ErrorRate < 1) {
+			return nil, errInvalidBloom
+		}
+		space, err := s.namespaces.get(in.Namespace)
+		if err != nil {
+			return nil, err
+		}
+		size, hashes := bloomSize(in.Capacity, in.ErrorRate)
+		// checked before allocating the filter, as freecache would refuse it
+		if size/8 > uint64(space.maxEntrySize()) || !space.fits(in.Key, bloomHeaderSize+int(size+7)/8) {
+			return nil, ErrTooLarge
+		}
+		filter := make([]byte, bloomHeaderSize+(size+7)/8)
+		binary.BigEndian.PutUint32(filter, hashes)
+		binary.BigEndian.PutUint64(filter[4:], size)
+
+		lock := s.keyLock(in.Key)
+		lock.Lock()
+		defer lock.Unlock()
+		if _, err := s.peekLocal(in.Namespace, in.Key); err == nil {
+			return nil, ErrAlreadyExists
+		} else if err != ErrNotFound {
+			return nil, err
+		}
+		if _, err := s.setLocal(in.Namespace, in.Key, pb.Kind_BLOOM, filter, in.Expiration, nil); err != nil {
+			return nil, err
+		}
+		return &pb.BloomReply{}, nil
+	} else {
+		reply, err := s.client.BFCreateItem(nodeAddress, in)
+		if status.Code(err) == 14 { // Connection Error server is down
+			s.suspectServer(nodeAddress)
+		}
+		return reply, err
+	}
+}
+
+/* With consistent hashing check if key belongs to you, if so add to the filter in local cache. Otherwise send to other server with client
+The filter must have been created with BFCreate, returns ErrNotFound otherwise.
+*/
+func (s *Server) BFAdd(ctx context.Context, in *pb.BloomRequest) (*pb.BloomReply, error) {
+	log.Printf("Received: %v", in.Key)
+	nodeAddress := s.ring().Get(in.Key)
+	if nodeAddress == s.selfAddress {
+		reply := &pb.BloomReply{Results: make([]bool, len(in.Elements))}
+		_, err := s.updateKind(in.Namespace, in.Key, pb.Kind_BLOOM, 0, func(filter []byte) ([]byte, error) {
+			if filter == nil {
+				return nil, ErrNotFound
+			}
+			hashes, size, err := bloomHeader(filter)
+			if err != nil {
+				return nil, err
+			}
+			for i, element := range in.Elements {
+				for _, bit := range bloomBits(element, hashes, size) {
+					if filter[bloomHeaderSize+bit/8]&(1<<(bit%8)) == 0 {
+						filter[bloomHeaderSize+bit/8] |= 1 << (bit % 8)
+						reply.Results[i] = true
+					}
+				}
+			}
+			return filter, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return reply, nil
+	} else {
+		reply, err := s.client.BFAddItem(nodeAddress, in)
+		if status.Code(err) == 14 { // Connection Error server is down
+			s.suspectServer(nodeAddress)
+		}
+		return reply, err
+	}
+}
+
+/* With consistent hashing check if key belongs to you, if so check the filter in local cache. Otherwise send to other server with client
+An element may have been added if all its bits are set. A missing filter contains nothing.
+*/
+func (s *Server) BFExists(ctx context.Context, in *pb.BloomRequest) (*pb.BloomReply, error) {
+	nodeAddress := s.ring().Get(in.Key)
+	if nodeAddress == s.selfAddress {
+		reply := &pb.BloomReply{Results: make([]bool, len(in.Elements))}
+		item, err := s.readKind(in.Namespace, in.Key, pb.Kind_BLOOM)
+		if err == ErrNotFound {
+			return reply, nil
+		} else if err != nil {
+			return nil, err
+		}
+		hashes, size, err := bloomHeader(item.Value)
+		if err != nil {
+			return nil, err
+		}
+		for i, element := range in.Elements {
+			reply.Results[i] = true
+			for _, bit := range bloomBits(element, hashes, size) {
+				if item.Value[bloomHeaderSize+bit/8]&(1<<(bit%8)) == 0 {
+					reply.Results[i] = false
+					break
+				}
+			}
+		}
+		return reply, nil
+	} else {
+		reply, err := s.client.BFExistsItem(nodeAddress, in)
+		if status.Code(err) == 14 { // Connection Error server is down
+			s.suspectServer(nodeAddress)
+		}
+		return reply, err
+	}
+}
+
+/*
+Returns the number of bits and of hashes of a filter holding capacity elements at the false positive rate errorRate.
+The number of bits is capped at 2^63, far above any filter a namespace can hold.
+*/
+func bloomSize(capacity uint64, errorRate float64) (uint64, uint32) {
+	size := math.Min(math.Ceil(-float64(capacity)*math.Log(errorRate)/(math.Ln2*math.Ln2)), 1<<63)
+	hashes := uint32(math.Max(1, math.Round(size/float64(capacity)*math.Ln2)))
+	return uint64(size), hashes
+}
+
+/*
+Layout: number of hashes (4 bytes) | number of bits (8 bytes) | bits
+*/
+func bloomHeader(filter []byte) (uint32, uint64, error) {
+	if len(filter) < bloomHeaderSize {
+		return 0, 0, errCorruptEntry
+	}
+	hashes, size := binary.BigEndian.Uint32(filter), binary.BigEndian.Uint64(filter[4:])
+	if size == 0 || uint64(len(filter)-bloomHeaderSize) < (size+7)/8 {
+		return 0, 0, errCorruptEntry
+	}
+	return hashes, size, nil
+}
+
+/*
+Positions of the bits of an element, derived from two hashes as h1 + i*h2.
+*/
+func bloomBits(element []byte, hashes uint32, size uint64) []uint64 {
+	h1 := hash64(element)
+	h2 := mix64(h1) | 1
+	positions := make([]uint64, hashes)
+	for i := range positions {
+		positions[i] = (h1 + uint64(i)*h2) % size
+	}
+	return positions
+}
@@ -0,0 +1,99 @@
+package src
+
+import (
+	"context"
+	pb "drcache/grpc/definitions"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestBloomSize(t *testing.T) {
+	tests := []struct {
+		capacity  uint64
+		errorRate float64
+		size      uint64
+		hashes    uint32
+	}{
+		{10, 0.01, 96, 7},
+		{1000, 0.01, 9586, 7},
+		{1000, 0.5, 1443, 1},
+		{1, 0.99, 1, 1},
+	}
+	for _, test := range tests {
+		size, hashes := bloomSize(test.capacity, test.errorRate)
+		if size != test.size || hashes != test.hashes {
+			t.Errorf("bloomSize(%v, %v) = %v, %v, want %v, %v", test.capacity, test.errorRate, size, hashes, test.size, test.hashes)
+		}
+	}
+}
+
+func TestBloomFalsePositiveRate(t *testing.T) {
+	const capacity, errorRate = 1000, 0.01
+	size, hashes := bloomSize(capacity, errorRate)
+	filter := make([]bool, size)
+	for i := 0; i < capacity; i++ {
+		for _, bit := range bloomBits([]byte(fmt.Sprint("in-", i)), hashes, size) {
+			filter[bit] = true
+		}
+	}
+	positives := 0
+	const probes = 100000
+	for i := 0; i < probes; i++ {
+		present := true
+		for _, bit := range bloomBits([]byte(fmt.Sprint("out-", i)), hashes, size) {
+			present = present && filter[bit]
+		}
+		if present {
+			positives++
+		}
+	}
+	if rate := float64(positives) / probes; rate > 2*errorRate {
+		t.Errorf("false positive rate %v, want about %v", rate, errorRate)
+	}
+}
+
+func TestBFCreateFitsNamespace(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	if _, err := s.BFCreate(ctx, &pb.BloomCreateRequest{Key: "small", Capacity: 10, ErrorRate: 0.01}); err != nil {
+		t.Errorf("a filter for 10 elements was refused: %v", err)
+	}
+	if _, err := s.BFCreate(ctx, &pb.BloomCreateRequest{Key: "large", Capacity: 1000, ErrorRate: 0.01}); !errors.Is(err, ErrTooLarge) {
+		t.Errorf("a filter over the entry limit returned %v", err)
+	}
+	if _, err := s.BFCreate(ctx, &pb.BloomCreateRequest{Key: "huge", Capacity: 1 << 62, ErrorRate: 1e-9}); !errors.Is(err, ErrTooLarge) {
+		t.Errorf("a huge filter returned %v", err)
+	}
+}
+
+func TestBloomAddAndExists(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	elements := [][]byte{[]byte("a"), []byte("b")}
+	if _, err := s.BFAdd(ctx, &pb.BloomRequest{Key: "seen", Elements: elements}); !errors.Is(err, ErrNotFound) {
+		t.Errorf("BFAdd before BFCreate returned %v", err)
+	}
+	if reply, err := s.BFExists(ctx, &pb.BloomRequest{Key: "seen", Elements: elements}); err != nil || fmt.Sprint(reply.Results) != "[false false]" {
+		t.Errorf("BFExists of a missing filter returned %v, %v", reply, err)
+	}
+	if _, err := s.BFCreate(ctx, &pb.BloomCreateRequest{Key: "seen", Capacity: 10, ErrorRate: 0.01}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.BFCreate(ctx, &pb.BloomCreateRequest{Key: "seen", Capacity: 10, ErrorRate: 0.01}); !errors.Is(err, ErrAlreadyExists) {
+		t.Errorf("second BFCreate returned %v", err)
+	}
+	if _, err := s.BFCreate(ctx, &pb.BloomCreateRequest{Key: "other", Capacity: 10, ErrorRate: 1}); !errors.Is(err, errInvalidBloom) {
+		t.Errorf("BFCreate with an error rate of 1 returned %v", err)
+	}
+
+	if reply, err := s.BFAdd(ctx, &pb.BloomRequest{Key: "seen", Elements: [][]byte{[]byte("a")}}); err != nil || fmt.Sprint(reply.Results) != "[true]" {
+		t.Errorf("BFAdd of a new element returned %v, %v", reply, err)
+	}
+	if reply, err := s.BFAdd(ctx, &pb.BloomRequest{Key: "seen", Elements: [][]byte{[]byte("a")}}); err != nil || fmt.Sprint(reply.Results) != "[false]" {
+		t.Errorf("BFAdd of a known element returned %v, %v", reply, err)
+	}
+	if reply, err := s.BFExists(ctx, &pb.BloomRequest{Key: "seen", Elements: elements}); err != nil || fmt.Sprint(reply.Results) != "[true false]" {
+		t.Errorf("BFExists returned %v, %v", reply, err)
+	}
+}
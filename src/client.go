@@ -9,7 +9,9 @@ import (
 )
 
 type Client struct {
-	Clients map[string]pb.DrcacheClient
+	Clients    map[string]pb.DrcacheClient
+	forwarded  *counters // requests forwarded to the owner of their key, per RPC
+	clusterKey string    // sent on every request by the client of a member, empty for other clients
 	sync.Mutex
 }
 
@@ -19,43 +21,266 @@ var once sync.Once
 func NewClient(ServerList map[string]struct{}, self string) *Client {
 
 	once.Do(func() { // <-- atomic, does not allow repeating
-		clients := make(map[string]pb.DrcacheClient)
-		for address := range ServerList {
-			if address == self {
-				continue
-			}
-			conn, err := grpc.Dial(address, grpc.WithInsecure())
-			if err != nil {
-				log.Fatalf("did not connect to %s: %v", address, err)
-			}
-			c := pb.NewDrcacheClient(conn)
-			clients[address] = c
-		}
-		client = &Client{Clients: clients}
+		client = newClient(ServerList, self, "")
 	})
 	return client
 }
 
+/*
+Returns a client dialing the servers of the list. A server gets its own, with the cluster key that identifies it
+to its peers, so that the key never ends up in the client NewClient shares with the rest of the process.
+*/
+func newClient(ServerList map[string]struct{}, self string, clusterKey string) *Client {
+	c := &Client{Clients: make(map[string]pb.DrcacheClient), forwarded: newCounters(), clusterKey: clusterKey}
+	for address := range ServerList {
+		if address == self {
+			continue
+		}
+		c.conn(address)
+	}
+	return c
+}
+
+/*
+Returns the client of a server, dialing it on first use so that members learned after startup can be reached.
+*/
+func (c *Client) conn(address string) pb.DrcacheClient {
+	c.Lock()
+	defer c.Unlock()
+	if cl, ok := c.Clients[address]; ok {
+		return cl
+	}
+	unary := []grpc.UnaryClientInterceptor{c.forwarded.countUnary, typedErrorsUnary}
+	stream := []grpc.StreamClientInterceptor{c.forwarded.countStream, typedErrorsStream}
+	if c.clusterKey != "" {
+		unary = []grpc.UnaryClientInterceptor{c.forwarded.countUnary, c.peerUnary, typedErrorsUnary}
+		stream = []grpc.StreamClientInterceptor{c.forwarded.countStream, c.peerStream, typedErrorsStream}
+	}
+	conn, err := grpc.Dial(address, grpc.WithInsecure(), grpc.WithChainUnaryInterceptor(unary...), grpc.WithChainStreamInterceptor(stream...))
+	if err != nil {
+		log.Fatalf("did not connect to %s: %v", address, err)
+	}
+	cl := pb.NewDrcacheClient(conn)
+	c.Clients[address] = cl
+	return cl
+}
+
 func (c *Client) GetServers(address string) (*pb.ServerList, error) {
-	return c.Clients[address].GetServers(context.Background(), &pb.GetServersRequest{})
+	return c.conn(address).GetServers(context.Background(), &pb.GetServersRequest{})
+}
+
+func (c *Client) AddServer(address string, request *pb.AddServerRequest) (*pb.Reply, error) {
+	return c.conn(address).AddServer(context.Background(), request)
 }
 
 func (c *Client) AddItem(address string, request *pb.AddRequest) (*pb.Reply, error) {
-	return c.Clients[address].Add(context.Background(), request)
+	return c.conn(address).Add(context.Background(), request)
 }
 
 func (c *Client) GetItem(address string, request *pb.GetRequest) (*pb.Reply, error) {
-	return c.Clients[address].Get(context.Background(), request)
+	return c.conn(address).Get(context.Background(), request)
 }
 
 func (c *Client) SetItem(address string, request *pb.SetRequest) (*pb.Reply, error) {
-	return c.Clients[address].Set(context.Background(), request)
+	return c.conn(address).Set(context.Background(), request)
+}
+
+func (c *Client) HandOffItem(address string, request *pb.HandOffRequest) (*pb.Reply, error) {
+	return c.conn(address).HandOff(context.Background(), request)
 }
 
-func (c *Client) DropServer(address string) (*pb.Reply, error) {
-	return c.Clients[address].DropServer(context.Background(), &pb.DropServerRequest{Server: address})
+func (c *Client) DropServer(address string, server string) (*pb.Reply, error) {
+	return c.conn(address).DropServer(context.Background(), &pb.DropServerRequest{Server: server})
 }
 
 func (c *Client) DeleteItem(address string, request *pb.DeleteRequest) (*pb.Reply, error) {
-	return c.Clients[address].Delete(context.Background(), request)
+	return c.conn(address).Delete(context.Background(), request)
+}
+
+func (c *Client) CompareAndSwapItem(address string, request *pb.CompareAndSwapRequest) (*pb.Reply, error) {
+	return c.conn(address).CompareAndSwap(context.Background(), request)
+}
+
+func (c *Client) MultiGetItems(address string, request *pb.MultiGetRequest) (*pb.MultiReply, error) {
+	return c.conn(address).MultiGet(context.Background(), request)
+}
+
+func (c *Client) MultiSetItems(address string, request *pb.MultiSetRequest) (*pb.MultiReply, error) {
+	return c.conn(address).MultiSet(context.Background(), request)
+}
+
+func (c *Client) MultiDeleteItems(address string, request *pb.MultiDeleteRequest) (*pb.MultiReply, error) {
+	return c.conn(address).MultiDelete(context.Background(), request)
+}
+
+func (c *Client) WatchItems(ctx context.Context, address string, request *pb.WatchRequest) (pb.Drcache_WatchClient, error) {
+	return c.conn(address).Watch(ctx, request)
+}
+
+func (c *Client) IncrItem(address string, request *pb.CounterRequest) (*pb.Reply, error) {
+	return c.conn(address).Incr(context.Background(), request)
+}
+
+func (c *Client) DecrItem(address string, request *pb.CounterRequest) (*pb.Reply, error) {
+	return c.conn(address).Decr(context.Background(), request)
+}
+
+func (c *Client) AppendItem(address string, request *pb.AppendRequest) (*pb.Reply, error) {
+	return c.conn(address).Append(context.Background(), request)
+}
+
+func (c *Client) PrependItem(address string, request *pb.AppendRequest) (*pb.Reply, error) {
+	return c.conn(address).Prepend(context.Background(), request)
+}
+
+func (c *Client) TouchItem(address string, request *pb.TouchRequest) (*pb.Reply, error) {
+	return c.conn(address).Touch(context.Background(), request)
+}
+
+func (c *Client) GetAndTouchItem(address string, request *pb.TouchRequest) (*pb.Reply, error) {
+	return c.conn(address).GetAndTouch(context.Background(), request)
+}
+
+func (c *Client) ScanItems(address string, request *pb.ScanRequest) (*pb.ScanReply, error) {
+	return c.conn(address).Scan(context.Background(), request)
+}
+
+func (c *Client) CreateNamespace(address string, request *pb.NamespaceRequest) (*pb.Reply, error) {
+	return c.conn(address).CreateNamespace(context.Background(), request)
+}
+
+func (c *Client) DeleteAll(address string, request *pb.DeleteAllRequest) (*pb.ClusterReply, error) {
+	return c.conn(address).DeleteAll(context.Background(), request)
+}
+
+func (c *Client) InvalidateTag(address string, request *pb.InvalidateTagRequest) (*pb.ClusterReply, error) {
+	return c.conn(address).InvalidateTag(context.Background(), request)
+}
+
+func (c *Client) Stats(address string, request *pb.StatsRequest) (*pb.StatsReply, error) {
+	return c.conn(address).Stats(context.Background(), request)
+}
+
+func (c *Client) HSetItem(address string, request *pb.HashSetRequest) (*pb.HashReply, error) {
+	return c.conn(address).HSet(context.Background(), request)
+}
+
+func (c *Client) HGetItem(address string, request *pb.HashGetRequest) (*pb.HashReply, error) {
+	return c.conn(address).HGet(context.Background(), request)
+}
+
+func (c *Client) HGetAllItem(address string, request *pb.HashGetRequest) (*pb.HashReply, error) {
+	return c.conn(address).HGetAll(context.Background(), request)
+}
+
+func (c *Client) HDelItem(address string, request *pb.HashGetRequest) (*pb.HashReply, error) {
+	return c.conn(address).HDel(context.Background(), request)
+}
+
+func (c *Client) HIncrItem(address string, request *pb.HashIncrRequest) (*pb.HashReply, error) {
+	return c.conn(address).HIncr(context.Background(), request)
+}
+
+func (c *Client) LPushItem(address string, request *pb.ListPushRequest) (*pb.ListReply, error) {
+	return c.conn(address).LPush(context.Background(), request)
+}
+
+func (c *Client) RPushItem(address string, request *pb.ListPushRequest) (*pb.ListReply, error) {
+	return c.conn(address).RPush(context.Background(), request)
+}
+
+func (c *Client) LPopItem(address string, request *pb.ListPopRequest) (*pb.ListReply, error) {
+	return c.conn(address).LPop(context.Background(), request)
+}
+
+func (c *Client) RPopItem(address string, request *pb.ListPopRequest) (*pb.ListReply, error) {
+	return c.conn(address).RPop(context.Background(), request)
+}
+
+func (c *Client) BLPopItem(ctx context.Context, address string, request *pb.ListPopRequest) (*pb.ListReply, error) {
+	return c.conn(address).BLPop(ctx, request)
+}
+
+func (c *Client) BRPopItem(ctx context.Context, address string, request *pb.ListPopRequest) (*pb.ListReply, error) {
+	return c.conn(address).BRPop(ctx, request)
+}
+
+func (c *Client) LRangeItems(address string, request *pb.ListRangeRequest) (*pb.ListReply, error) {
+	return c.conn(address).LRange(context.Background(), request)
+}
+
+func (c *Client) ZAddItem(address string, request *pb.SortedSetAddRequest) (*pb.SortedSetReply, error) {
+	return c.conn(address).ZAdd(context.Background(), request)
+}
+
+func (c *Client) ZIncrByItem(address string, request *pb.SortedSetIncrRequest) (*pb.SortedSetReply, error) {
+	return c.conn(address).ZIncrBy(context.Background(), request)
+}
+
+func (c *Client) ZRangeItems(address string, request *pb.SortedSetRangeRequest) (*pb.SortedSetReply, error) {
+	return c.conn(address).ZRange(context.Background(), request)
+}
+
+func (c *Client) ZRangeByScoreItems(address string, request *pb.SortedSetScoreRequest) (*pb.SortedSetReply, error) {
+	return c.conn(address).ZRangeByScore(context.Background(), request)
+}
+
+func (c *Client) ZRemRangeByRankItems(address string, request *pb.SortedSetRangeRequest) (*pb.SortedSetReply, error) {
+	return c.conn(address).ZRemRangeByRank(context.Background(), request)
+}
+
+func (c *Client) ZRemRangeByScoreItems(address string, request *pb.SortedSetScoreRequest) (*pb.SortedSetReply, error) {
+	return c.conn(address).ZRemRangeByScore(context.Background(), request)
+}
+
+func (c *Client) PublishMessage(address string, request *pb.PublishRequest) (*pb.PublishReply, error) {
+	return c.conn(address).Publish(context.Background(), request)
+}
+
+func (c *Client) SubscribeChannels(ctx context.Context, address string, request *pb.SubscribeRequest) (pb.Drcache_SubscribeClient, error) {
+	return c.conn(address).Subscribe(ctx, request)
+}
+
+func (c *Client) LockItem(address string, request *pb.LockRequest) (*pb.LockReply, error) {
+	return c.conn(address).Lock(context.Background(), request)
+}
+
+func (c *Client) UnlockItem(address string, request *pb.LockRequest) (*pb.LockReply, error) {
+	return c.conn(address).Unlock(context.Background(), request)
+}
+
+func (c *Client) RefreshLockItem(address string, request *pb.LockRequest) (*pb.LockReply, error) {
+	return c.conn(address).RefreshLock(context.Background(), request)
+}
+
+func (c *Client) RateLimitItem(address string, request *pb.RateLimitRequest) (*pb.RateLimitReply, error) {
+	return c.conn(address).RateLimit(context.Background(), request)
+}
+
+func (c *Client) PFAddItem(address string, request *pb.HyperLogLogAddRequest) (*pb.HyperLogLogReply, error) {
+	return c.conn(address).PFAdd(context.Background(), request)
+}
+
+func (c *Client) PFMergeItem(address string, request *pb.HyperLogLogMergeRequest) (*pb.HyperLogLogReply, error) {
+	return c.conn(address).PFMerge(context.Background(), request)
+}
+
+func (c *Client) BFCreateItem(address string, request *pb.BloomCreateRequest) (*pb.BloomReply, error) {
+	return c.conn(address).BFCreate(context.Background(), request)
+}
+
+func (c *Client) BFAddItem(address string, request *pb.BloomRequest) (*pb.BloomReply, error) {
+	return c.conn(address).BFAdd(context.Background(), request)
+}
+
+func (c *Client) BFExistsItem(address string, request *pb.BloomRequest) (*pb.BloomReply, error) {
+	return c.conn(address).BFExists(context.Background(), request)
+}
+
+func (c *Client) PingMember(ctx context.Context, address string, request *pb.PingRequest) (*pb.PingReply, error) {
+	return c.conn(address).Ping(ctx, request)
+}
+
+func (c *Client) PingReqMember(ctx context.Context, address string, request *pb.PingReqRequest) (*pb.PingReply, error) {
+	return c.conn(address).PingReq(ctx, request)
 }
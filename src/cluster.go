@@ -0,0 +1,82 @@
+package src
+
+import (
+	"context"
+	pb "drcache/grpc/definitions"
+	"google.golang.org/grpc/status"
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+/*
+Runs local on this node and remote for every peer in parallel, and reports the outcome of every node.
+Both return the number of keys the node dropped, if the operation counts them.
+*/
+func (s *Server) broadcast(local func() (uint64, error), remote func(address string) (uint64, error)) []*pb.NodeResult {
+	peers := s.peers()
+	nodes := make([]*pb.NodeResult, len(peers)+1)
+	var wg sync.WaitGroup
+	for i, address := range peers {
+		wg.Add(1)
+		go func(i int, address string) {
+			defer wg.Done()
+			affected, err := remote(address)
+			if status.Code(err) == 14 { // Connection Error server is down
+				s.suspectServer(address)
+			}
+			nodes[i] = nodeResult(address, affected, err)
+		}(i, address)
+	}
+	affected, err := local()
+	nodes[len(peers)] = nodeResult(s.selfAddress, affected, err)
+	wg.Wait()
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Address < nodes[j].Address })
+	return nodes
+}
+
+func nodeResult(address string, affected uint64, err error) *pb.NodeResult {
+	if err != nil {
+		return &pb.NodeResult{Address: address, Error: err.Error(), Code: uint32(status.Code(err))}
+	}
+	return &pb.NodeResult{Address: address, Affected: affected}
+}
+
+/*
+Reads the count a peer reported for itself when it ran the local half of a broadcast.
+*/
+func peerAffected(reply *pb.ClusterReply, err error) (uint64, error) {
+	if err != nil || len(reply.Nodes) == 0 {
+		return 0, err
+	}
+	return reply.Nodes[0].Affected, nil
+}
+
+/*
+Derives a context from ctx that is also cancelled when moved reports true after a ring change, for streams
+that were set up from the ring and must end when it no longer holds. The returned func reports whether the
+context was cancelled for that reason.
+*/
+func (s *Server) cancelOnMove(ctx context.Context, moved func() bool) (context.Context, context.CancelFunc, func() bool) {
+	ctx, cancel := context.WithCancel(ctx)
+	var flag int32
+	changed := s.ringChange()
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-changed:
+			}
+			changed = s.ringChange()
+			if moved() {
+				atomic.StoreInt32(&flag, 1)
+				cancel()
+				return
+			}
+		}
+	}()
+	return ctx, cancel, func() bool {
+		return atomic.LoadInt32(&flag) == 1
+	}
+}
@@ -0,0 +1,132 @@
+package src
+
+import (
+	"context"
+	pb "drcache/grpc/definitions"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestJoinTakesOverKeys(t *testing.T) {
+	ctx := context.Background()
+	first := startNode(t)
+	if err := first.Join(nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := first.CreateNamespace(ctx, &pb.NamespaceRequest{Namespace: "sessions", Quota: 1 << 20}); err != nil {
+		t.Fatal(err)
+	}
+	const keys = 100
+	for i := 0; i < keys; i++ {
+		item := &pb.Item{Key: fmt.Sprint("key-", i), Value: []byte(fmt.Sprint(i)), Expiration: 300}
+		if _, err := first.Set(ctx, &pb.SetRequest{Item: item, Namespace: "sessions"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := first.Lock(ctx, &pb.LockRequest{Key: "key-lock", Ttl: 60, Holder: "test", Namespace: "sessions"}); err != nil {
+		t.Fatal(err)
+	}
+
+	second := startNode(t)
+	if err := second.Join([]string{first.selfAddress}); err != nil {
+		t.Fatal(err)
+	}
+	if len(first.peers()) != 1 || len(second.peers()) != 1 {
+		t.Fatalf("peers after join: %v and %v", first.peers(), second.peers())
+	}
+	moved := second.entries("sessions")
+	if moved == 0 || moved == keys+1 || first.entries("sessions")+moved != keys+1 {
+		t.Fatalf("keys split %v and %v after join", first.entries("sessions"), moved)
+	}
+	checkKeys(t, second.Server, keys)
+	if _, err := second.Lock(ctx, &pb.LockRequest{Key: "key-lock", Ttl: 60, Holder: "other", Namespace: "sessions"}); !errors.Is(err, ErrLocked) {
+		t.Errorf("lock was not held across the join: %v", err)
+	}
+}
+
+func checkKeys(t *testing.T, s *Server, keys int) {
+	t.Helper()
+	for i := 0; i < keys; i++ {
+		reply, err := s.Get(context.Background(), &pb.GetRequest{Key: fmt.Sprint("key-", i), Namespace: "sessions"})
+		if err != nil {
+			t.Fatalf("key-%v: %v", i, err)
+		}
+		if string(reply.Item.Value) != fmt.Sprint(i) || reply.Item.Expiration == 0 {
+			t.Fatalf("key-%v is %q expiring at %v", i, reply.Item.Value, reply.Item.Expiration)
+		}
+	}
+}
+
+func TestJoiningNodeOnlyServesPeers(t *testing.T) {
+	node := startNode(t)
+	public := NewClient(nil, "")
+	if _, err := public.GetItem(node.selfAddress, &pb.GetRequest{Key: "key"}); !errors.Is(err, errJoining) {
+		t.Errorf("client served before the join: %v", err)
+	}
+	other := newClient(nil, "", "another-cluster-key")
+	if _, err := other.GetItem(node.selfAddress, &pb.GetRequest{Key: "key"}); !errors.Is(err, errJoining) {
+		t.Errorf("member of another cluster served before the join: %v", err)
+	}
+	peer := newClient(nil, "", testClusterKey)
+	if _, err := peer.GetItem(node.selfAddress, &pb.GetRequest{Key: "key"}); !errors.Is(err, ErrNotFound) {
+		t.Errorf("peer not served before the join: %v", err)
+	}
+}
+
+func TestPeerOnlyCalls(t *testing.T) {
+	node := startNode(t)
+	if err := node.Join(nil); err != nil {
+		t.Fatal(err)
+	}
+	forged := (&entry{kind: pb.Kind_STRING, value: []byte("forged")}).encode()
+	for _, c := range []*Client{NewClient(nil, ""), newClient(nil, "", "another-cluster-key")} {
+		if _, err := c.HandOffItem(node.selfAddress, &pb.HandOffRequest{Key: "key", Entry: forged}); !errors.Is(err, errPeerOnly) {
+			t.Errorf("HandOff from a client returned %v", err)
+		}
+		if _, err := c.AddServer(node.selfAddress, &pb.AddServerRequest{Address: "127.0.0.1:1"}); !errors.Is(err, errPeerOnly) {
+			t.Errorf("AddServer from a client returned %v", err)
+		}
+		if _, err := c.PingMember(context.Background(), node.selfAddress, &pb.PingRequest{}); !errors.Is(err, errPeerOnly) {
+			t.Errorf("Ping from a client returned %v", err)
+		}
+	}
+	if _, err := getString(t, node.Server, "key"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("handed over entry was stored: %v", err)
+	}
+	if len(node.peers()) != 0 {
+		t.Errorf("members added by a client: %v", node.peers())
+	}
+	peer := newClient(nil, "", testClusterKey)
+	if _, err := peer.HandOffItem(node.selfAddress, &pb.HandOffRequest{Key: "key", Entry: forged}); err != nil {
+		t.Errorf("HandOff from a peer: %v", err)
+	}
+}
+
+func TestLeaveHandsOverKeys(t *testing.T) {
+	ctx := context.Background()
+	first, second := startCluster(t)
+	if _, err := first.CreateNamespace(ctx, &pb.NamespaceRequest{Namespace: "sessions", Quota: 1 << 20}); err != nil {
+		t.Fatal(err)
+	}
+	// more keys than handoff workers, so that workers send several keys each
+	const keys = 20 * handOffWorkers
+	for i := 0; i < keys; i++ {
+		item := &pb.Item{Key: fmt.Sprint("key-", i), Value: []byte(fmt.Sprint(i)), Expiration: 300}
+		if _, err := first.Set(ctx, &pb.SetRequest{Item: item, Namespace: "sessions"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if second.entries("sessions") == 0 {
+		t.Fatal("second owns none of the keys")
+	}
+
+	second.Leave()
+	if len(first.peers()) != 0 {
+		t.Fatalf("peers after leave: %v", first.peers())
+	}
+	if first.entries("sessions") != keys || second.entries("sessions") != 0 {
+		t.Fatalf("keys split %v and %v after leave", first.entries("sessions"), second.entries("sessions"))
+	}
+	checkKeys(t, first.Server, keys)
+}
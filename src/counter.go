@@ -0,0 +1,95 @@
+package src
+
+import (
+	"context"
+	pb "drcache/grpc/definitions"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log"
+	"math"
+	"strconv"
+)
+
+var errNotInteger = &Error{codes.InvalidArgument, "NOT_INTEGER", "Value is not a 64-bit integer."}
+var errCounterOverflow = &Error{codes.OutOfRange, "COUNTER_OVERFLOW", "Counter would overflow."}
+
+/* With consistent hashing check if key belongs to you, if so increment in local cache. Otherwise send to other server with client
+The stored value is parsed as a decimal 64-bit integer, the new value is returned in the reply item.
+If entry does not exist, it is created with the initial value when create is set, otherwise returns error.
+*/
+func (s *Server) Incr(ctx context.Context, in *pb.CounterRequest) (*pb.Reply, error) {
+	log.Printf("Received: %v", in.Key)
+	nodeAddress := s.ring().Get(in.Key)
+	if nodeAddress == s.selfAddress {
+		return s.addToCounter(in, false)
+	} else {
+		reply, err := s.client.IncrItem(nodeAddress, in)
+		if status.Code(err) == 14 { // Connection Error server is down
+			s.suspectServer(nodeAddress)
+		}
+		return reply, err
+	}
+}
+
+/* With consistent hashing check if key belongs to you, if so decrement in local cache. Otherwise send to other server with client
+Same as Incr, subtracting the delta instead. Counters may go below zero.
+*/
+func (s *Server) Decr(ctx context.Context, in *pb.CounterRequest) (*pb.Reply, error) {
+	log.Printf("Received: %v", in.Key)
+	nodeAddress := s.ring().Get(in.Key)
+	if nodeAddress == s.selfAddress {
+		return s.addToCounter(in, true)
+	} else {
+		reply, err := s.client.DecrItem(nodeAddress, in)
+		if status.Code(err) == 14 { // Connection Error server is down
+			s.suspectServer(nodeAddress)
+		}
+		return reply, err
+	}
+}
+
+/*
+Applies the delta to a local counter, keeping its remaining expiration.
+*/
+func (s *Server) addToCounter(in *pb.CounterRequest, negative bool) (*pb.Reply, error) {
+	if in.Delta > math.MaxInt64 {
+		return nil, errCounterOverflow
+	}
+	delta := int64(in.Delta)
+	if negative {
+		delta = -delta
+	}
+	lock := s.keyLock(in.Key)
+	lock.Lock()
+	defer lock.Unlock()
+
+	var value int64
+	var expiration uint32
+	var tags []string
+	current, err := s.getLocal(in.Namespace, in.Key)
+	if err == ErrNotFound && in.Create {
+		value = in.Initial
+		expiration = in.Expiration
+	} else if err != nil {
+		return nil, err
+	} else if current.Kind != pb.Kind_STRING {
+		return nil, errWrongKind
+	} else {
+		stored, err := strconv.ParseInt(string(current.Value), 10, 64)
+		if err != nil {
+			return nil, errNotInteger
+		}
+		if (delta > 0 && stored > math.MaxInt64-delta) || (delta < 0 && stored < math.MinInt64-delta) {
+			return nil, errCounterOverflow
+		}
+		value = stored + delta
+		expiration = remainingTTL(current.Expiration)
+		tags = current.Tags
+	}
+	item, err := s.setLocal(in.Namespace, in.Key, pb.Kind_STRING, []byte(strconv.FormatInt(value, 10)), expiration, tags)
+	if err != nil {
+		return nil, err
+	}
+	item.Value = []byte(strconv.FormatInt(value, 10))
+	return &pb.Reply{Message: "ok", Item: item}, nil
+}
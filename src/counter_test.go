@@ -0,0 +1,78 @@
+package src
+
+import (
+	"context"
+	pb "drcache/grpc/definitions"
+	"errors"
+	"math"
+	"sync"
+	"testing"
+)
+
+func TestIncrAndDecr(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	if _, err := s.Incr(ctx, &pb.CounterRequest{Key: "hits", Delta: 1}); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Incr of a missing key without create returned %v", err)
+	}
+	reply, err := s.Incr(ctx, &pb.CounterRequest{Key: "hits", Delta: 5, Create: true, Initial: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(reply.Item.Value) != "10" {
+		t.Errorf("created counter is %s, want the initial value", reply.Item.Value)
+	}
+	if reply, err = s.Incr(ctx, &pb.CounterRequest{Key: "hits", Delta: 5, Create: true, Initial: 10}); err != nil || string(reply.Item.Value) != "15" {
+		t.Errorf("Incr returned %v, %v", reply, err)
+	}
+	if reply, err = s.Decr(ctx, &pb.CounterRequest{Key: "hits", Delta: 20}); err != nil || string(reply.Item.Value) != "-5" {
+		t.Errorf("Decr below zero returned %v, %v", reply, err)
+	}
+	if value, _ := getString(t, s, "hits"); value != "-5" {
+		t.Errorf("stored counter is %q", value)
+	}
+}
+
+func TestIncrErrors(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	setString(t, s, "text", "not a number")
+	if _, err := s.Incr(ctx, &pb.CounterRequest{Key: "text", Delta: 1}); !errors.Is(err, errNotInteger) {
+		t.Errorf("Incr of text returned %v", err)
+	}
+	if _, err := s.Incr(ctx, &pb.CounterRequest{Key: "max", Delta: 1, Create: true, Initial: math.MaxInt64}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Incr(ctx, &pb.CounterRequest{Key: "max", Delta: 1}); !errors.Is(err, errCounterOverflow) {
+		t.Errorf("Incr past MaxInt64 returned %v", err)
+	}
+	if _, err := s.Decr(ctx, &pb.CounterRequest{Key: "max", Delta: math.MaxInt64 + 1}); !errors.Is(err, errCounterOverflow) {
+		t.Errorf("delta above MaxInt64 returned %v", err)
+	}
+	if value, _ := getString(t, s, "max"); value != "9223372036854775807" {
+		t.Errorf("a refused Incr changed the counter to %q", value)
+	}
+}
+
+func TestIncrIsAtomic(t *testing.T) {
+	s := newTestServer(t)
+	const callers, increments = 8, 100
+	var wg sync.WaitGroup
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < increments; j++ {
+				if _, err := s.Incr(context.Background(), &pb.CounterRequest{Key: "counter", Delta: 1, Create: true, Initial: 1}); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	// the first Incr creates the counter with its initial value instead of adding the delta
+	if value, _ := getString(t, s, "counter"); value != "800" {
+		t.Errorf("counter is %q after %v increments", value, callers*increments)
+	}
+}
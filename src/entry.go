@@ -0,0 +1,107 @@
+package src
+
+import (
+	pb "drcache/grpc/definitions"
+	"encoding/binary"
+	"google.golang.org/grpc/codes"
+	"math"
+	"time"
+)
+
+var errCorruptEntry = &Error{codes.Internal, "CORRUPT_ENTRY", "Corrupt cache entry."}
+var errWrongKind = &Error{codes.InvalidArgument, "WRONG_KIND", "Operation against a key holding the wrong kind of value."}
+
+const headerSize = 13
+
+/*
+Values are not stored in freecache as is. Every entry is prefixed with the CAS version of the write that produced it,
+its expiry, the kind of its value and its tags, so that they survive as long as the value does.
+freecache's Peek and TTL do not tell an entry expiring this second from one that never expires, so the entry
+carries its own expiry and expired() decides whether it is gone.
+Layout: version (8 bytes) | expireAt (4 bytes) | kind (1 byte) | number of tags (uvarint) | for every tag its length (uvarint) and bytes | value
+*/
+type entry struct {
+	version  uint64
+	expireAt uint32 // unix seconds, 0 when the entry does not expire
+	kind     pb.Kind
+	tags     []string
+	value    []byte
+}
+
+func (e *entry) encode() []byte {
+	size := headerSize + binary.MaxVarintLen64 + len(e.value)
+	for _, tag := range e.tags {
+		size += binary.MaxVarintLen64 + len(tag)
+	}
+	buf := make([]byte, size)
+	binary.BigEndian.PutUint64(buf, e.version)
+	binary.BigEndian.PutUint32(buf[8:], e.expireAt)
+	buf[12] = byte(e.kind)
+	n := headerSize
+	n += binary.PutUvarint(buf[n:], uint64(len(e.tags)))
+	for _, tag := range e.tags {
+		n += binary.PutUvarint(buf[n:], uint64(len(tag)))
+		n += copy(buf[n:], tag)
+	}
+	n += copy(buf[n:], e.value)
+	return buf[:n]
+}
+
+func decodeEntry(raw []byte) (*entry, error) {
+	if len(raw) < headerSize {
+		return nil, errCorruptEntry
+	}
+	e := &entry{version: binary.BigEndian.Uint64(raw), expireAt: binary.BigEndian.Uint32(raw[8:]), kind: pb.Kind(raw[12])}
+	raw = raw[headerSize:]
+	count, n := binary.Uvarint(raw)
+	if n <= 0 {
+		return nil, errCorruptEntry
+	}
+	raw = raw[n:]
+	for i := uint64(0); i < count; i++ {
+		length, n := binary.Uvarint(raw)
+		if n <= 0 || uint64(len(raw)-n) < length {
+			return nil, errCorruptEntry
+		}
+		e.tags = append(e.tags, string(raw[n:n+int(length)]))
+		raw = raw[n+int(length):]
+	}
+	e.value = raw
+	return e, nil
+}
+
+/*
+Reports whether the entry has expired, freecache only drops it once it is read or its space is needed.
+*/
+func (e *entry) expired() bool {
+	return e.expireAt != 0 && e.expireAt <= uint32(time.Now().Unix())
+}
+
+/*
+Converts an expiration in seconds from now to the expireAt of an entry, as freecache does.
+*/
+func expiresAt(expiration uint32) uint32 {
+	if expiration == 0 {
+		return 0
+	}
+	return uint32(time.Now().Unix()) + expiration
+}
+
+/*
+Converts a computed expiration in seconds to one that can be stored, clamped so that expiresAt does not wrap around.
+*/
+func clampExpiration(seconds int64) uint32 {
+	if limit := math.MaxUint32 - time.Now().Unix(); seconds > limit {
+		return uint32(limit)
+	}
+	return uint32(seconds)
+}
+
+func (e *entry) hasTag(tag string) bool {
+	for _, t := range e.tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
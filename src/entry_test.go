@@ -0,0 +1,53 @@
+package src
+
+import (
+	"bytes"
+	pb "drcache/grpc/definitions"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestEntryEncoding(t *testing.T) {
+	tests := []*entry{
+		{version: 1, kind: pb.Kind_STRING, value: []byte("value")},
+		{version: 1 << 62, expireAt: 1 << 31, kind: pb.Kind_HASH, tags: []string{"a", "", "user:42"}, value: []byte{0, 1, 2}},
+		{version: 7, kind: pb.Kind_BLOOM},
+	}
+	for _, e := range tests {
+		decoded, err := decodeEntry(e.encode())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if decoded.version != e.version || decoded.expireAt != e.expireAt || decoded.kind != e.kind || !reflect.DeepEqual(decoded.tags, e.tags) || !bytes.Equal(decoded.value, e.value) {
+			t.Errorf("decoded %+v, want %+v", decoded, e)
+		}
+	}
+}
+
+func TestDecodeCorruptEntry(t *testing.T) {
+	valid := (&entry{version: 1, tags: []string{"tag"}, value: []byte("v")}).encode()
+	for _, raw := range [][]byte{nil, valid[:headerSize-1], valid[:headerSize], valid[:headerSize+2]} {
+		if _, err := decodeEntry(raw); err != errCorruptEntry {
+			t.Errorf("decodeEntry(%v) returned %v", raw, err)
+		}
+	}
+}
+
+func TestEntryExpired(t *testing.T) {
+	now := uint32(time.Now().Unix())
+	tests := []struct {
+		expireAt uint32
+		expired  bool
+	}{
+		{0, false},
+		{now + 2, false},
+		{now, true},
+		{now - 1, true},
+	}
+	for _, test := range tests {
+		if expired := (&entry{expireAt: test.expireAt}).expired(); expired != test.expired {
+			t.Errorf("entry expiring at %v, now %v: expired() = %v", test.expireAt, now, expired)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package src
+
+import (
+	"context"
+	pb "drcache/grpc/definitions"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/*
+Error carries a canonical gRPC status code and a stable reason, so it reaches the caller with both when a Server
+returns it and can be matched with errors.Is on the Client side. Errors match on code and reason, the message may differ.
+The reason travels as an ErrorReason status detail.
+*/
+type Error struct {
+	code    codes.Code
+	reason  string
+	message string
+}
+
+var (
+	ErrNotFound      = &Error{codes.NotFound, "NOT_FOUND", "Key does not exist."}
+	ErrAlreadyExists = &Error{codes.AlreadyExists, "ALREADY_EXISTS", "Key already exists."}
+	ErrModified      = &Error{codes.FailedPrecondition, "MODIFIED", "Key has been modified."}
+	ErrTooLarge      = &Error{codes.ResourceExhausted, "TOO_LARGE", "Item does not fit in cache."}
+	ErrUnavailable   = &Error{codes.Unavailable, "UNAVAILABLE", "Server is unavailable."}
+	ErrRingChanged   = &Error{codes.Unavailable, "RING_CHANGED", "Cluster membership changed, subscribe again."}
+	ErrLeaseHeld     = &Error{codes.Aborted, "LEASE_HELD", "Another client holds the lease for the key, retry shortly."}
+	ErrLeaseInvalid  = &Error{codes.FailedPrecondition, "LEASE_INVALID", "Lease has expired or was revoked by a write."}
+	ErrLocked        = &Error{codes.Aborted, "LOCKED", "Lock is held by another holder."}
+	ErrLockNotHeld   = &Error{codes.FailedPrecondition, "LOCK_NOT_HELD", "Lock has expired or is held with another token."}
+)
+
+func (e *Error) Error() string {
+	return e.message
+}
+
+func (e *Error) Code() codes.Code {
+	return e.code
+}
+
+func (e *Error) Reason() string {
+	return e.reason
+}
+
+func (e *Error) GRPCStatus() *status.Status {
+	s := status.New(e.code, e.message)
+	if detailed, err := s.WithDetails(&pb.ErrorReason{Reason: e.reason}); err == nil {
+		return detailed
+	}
+	return s
+}
+
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.code == e.code && t.reason == e.reason
+}
+
+/*
+Converts a status error received from a server into an *Error, keeping the server's message and reason.
+Errors without a status, like a cancelled context, are returned as is. Transport failures carry no reason,
+they are reported as ErrUnavailable.
+*/
+func typedError(err error) error {
+	if err == nil {
+		return nil
+	}
+	s, ok := status.FromError(err)
+	if !ok || s.Code() == codes.OK || s.Code() == codes.Unknown {
+		return err
+	}
+	reason := ""
+	for _, detail := range s.Details() {
+		if r, ok := detail.(*pb.ErrorReason); ok {
+			reason = r.Reason
+		}
+	}
+	if reason == "" && s.Code() == codes.Unavailable {
+		reason = ErrUnavailable.reason
+	}
+	return &Error{s.Code(), reason, s.Message()}
+}
+
+func typedErrorsUnary(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return typedError(invoker(ctx, method, req, reply, cc, opts...))
+}
+
+func typedErrorsStream(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	stream, err := streamer(ctx, desc, cc, method, opts...)
+	if err != nil {
+		return nil, typedError(err)
+	}
+	return &typedErrorStream{stream}, nil
+}
+
+type typedErrorStream struct {
+	grpc.ClientStream
+}
+
+func (s *typedErrorStream) RecvMsg(m interface{}) error {
+	return typedError(s.ClientStream.RecvMsg(m))
+}
@@ -0,0 +1,57 @@
+package src
+
+import (
+	pb "drcache/grpc/definitions"
+	"errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestTypedErrorsReachTheClient(t *testing.T) {
+	node := startNode(t)
+	if err := node.Join(nil); err != nil {
+		t.Fatal(err)
+	}
+	client := NewClient(nil, "")
+	_, err := client.GetItem(node.selfAddress, &pb.GetRequest{Key: "missing"})
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Get of a missing key returned %#v", err)
+	}
+	var typed *Error
+	if !errors.As(err, &typed) || typed.Code() != codes.NotFound || typed.Reason() != "NOT_FOUND" {
+		t.Errorf("error is %#v", err)
+	}
+	// both are NotFound, the reason tells them apart
+	_, err = client.GetItem(node.selfAddress, &pb.GetRequest{Key: "missing", Namespace: "missing"})
+	if !errors.Is(err, errNamespaceNotFound) || errors.Is(err, ErrNotFound) {
+		t.Errorf("Get in a missing namespace returned %#v", err)
+	}
+	if _, err := client.GetItem("127.0.0.1:1", &pb.GetRequest{Key: "key"}); !errors.Is(err, ErrUnavailable) {
+		t.Errorf("unreachable server returned %#v", err)
+	}
+}
+
+func TestTypedError(t *testing.T) {
+	tests := []struct {
+		err  error
+		want error
+	}{
+		{nil, nil},
+		{ErrModified, ErrModified},
+		{status.Error(codes.Unavailable, "connection refused"), ErrUnavailable},
+		{status.Error(codes.NotFound, "no reason"), &Error{codes.NotFound, "", ""}},
+	}
+	for _, test := range tests {
+		got := typedError(test.err)
+		if (got == nil) != (test.want == nil) || (got != nil && !errors.Is(got, test.want)) {
+			t.Errorf("typedError(%v) = %#v, want %#v", test.err, got, test.want)
+		}
+	}
+	if plain := errors.New("plain"); typedError(plain) != plain {
+		t.Error("an error without a status was converted")
+	}
+	if errors.Is(ErrModified, ErrLockNotHeld) {
+		t.Error("errors sharing a code match")
+	}
+}
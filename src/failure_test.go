@@ -0,0 +1,62 @@
+package src
+
+import (
+	pb "drcache/grpc/definitions"
+	"testing"
+	"time"
+)
+
+func memberState(s *Server, address string) pb.MemberUpdate_State {
+	s.membership.Lock()
+	defer s.membership.Unlock()
+	return s.membership.members[address].state
+}
+
+/*
+Waits until the checks started by suspectServer are done.
+*/
+func waitForChecks(t *testing.T, s *Server) {
+	t.Helper()
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		s.membership.Lock()
+		checking := len(s.membership.checking)
+		s.membership.Unlock()
+		if checking == 0 {
+			return
+		}
+	}
+	t.Fatal("checks did not end")
+}
+
+func TestFailedRequestToLivePeerKeepsIt(t *testing.T) {
+	first, second := startCluster(t)
+	first.suspectServer(second.selfAddress)
+	waitForChecks(t, first.Server)
+	if !isMember(first.Server, second.selfAddress) || memberState(first.Server, second.selfAddress) != pb.MemberUpdate_ALIVE {
+		t.Errorf("a live peer was suspected after one failed request, state %v", memberState(first.Server, second.selfAddress))
+	}
+}
+
+func TestUnreachablePeerIsDroppedAfterSuspicion(t *testing.T) {
+	first, second := startCluster(t)
+	second.grpc.Stop()
+	first.suspectServer(second.selfAddress)
+	waitForChecks(t, first.Server)
+	if memberState(first.Server, second.selfAddress) != pb.MemberUpdate_SUSPECT {
+		t.Fatalf("unreachable peer is %v", memberState(first.Server, second.selfAddress))
+	}
+	if !isMember(first.Server, second.selfAddress) {
+		t.Fatal("suspect was dropped before the suspicion timed out")
+	}
+	if expired := first.membership.expiredSuspects(); len(expired) != 0 {
+		t.Fatalf("fresh suspicion expired: %v", expired)
+	}
+
+	first.membership.Lock()
+	first.membership.members[second.selfAddress].suspectedAt = time.Now().Add(-suspicionTimeout - time.Second)
+	first.membership.Unlock()
+	first.applyUpdates(first.membership.expiredSuspects())
+	if memberState(first.Server, second.selfAddress) != pb.MemberUpdate_DEAD || isMember(first.Server, second.selfAddress) {
+		t.Errorf("peer suspected for longer than the timeout is still in the ring, state %v", memberState(first.Server, second.selfAddress))
+	}
+}
@@ -0,0 +1,368 @@
+package src
+
+import (
+	"context"
+	pb "drcache/grpc/definitions"
+	"log"
+	"math"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	protocolPeriod   = time.Second            // one member is probed per period
+	probeTimeout     = 500 * time.Millisecond // a direct probe that takes longer falls back to indirect probes
+	indirectProbes   = 3
+	suspicionTimeout = 5 * time.Second // a suspect that does not refute in time is declared dead
+	maxPiggyback     = 16              // membership updates carried by a single message
+)
+
+/*
+What this node knows about another member. Updates about a member are ordered by incarnation, which only the
+member itself raises, and at equal incarnation DEAD overrides SUSPECT which overrides ALIVE.
+*/
+type member struct {
+	state       pb.MemberUpdate_State
+	incarnation uint64
+	suspectedAt time.Time
+}
+
+type queuedUpdate struct {
+	update    *pb.MemberUpdate
+	transmits int
+}
+
+/*
+SWIM membership of this node: the state of every other member, and the updates still to be piggybacked on probes.
+Every update is sent a number of times that grows with the log of the cluster size, which is enough for it to reach
+every member with high probability. Dead members are kept, so that stale ALIVE updates can not bring them back.
+*/
+type membership struct {
+	self        string
+	incarnation uint64
+	members     map[string]*member
+	queue       []*queuedUpdate
+	order       []string            // members left to probe in this round
+	left        bool                // this node is leaving the cluster, so it no longer probes nor refutes
+	checking    map[string]struct{} // members probed after a failed request
+	sync.Mutex
+}
+
+/*
+The incarnation starts at the current time, so that a restarted node overrides the DEAD updates about its previous run.
+*/
+func newMembership(self string, initial map[string]struct{}) *membership {
+	m := &membership{self: self, incarnation: uint64(time.Now().UnixNano()), members: make(map[string]*member), checking: make(map[string]struct{})}
+	for address := range initial {
+		if address != self {
+			m.members[address] = &member{state: pb.MemberUpdate_ALIVE}
+		}
+	}
+	m.enqueue(&pb.MemberUpdate{Address: self, State: pb.MemberUpdate_ALIVE, Incarnation: m.incarnation})
+	return m
+}
+
+/*
+Applies an update received from the cluster, and queues it for dissemination if it is news.
+Reports whether the member joined or left the ring: suspects stay in the ring until they are declared dead.
+Suspicions about this node are refuted by raising its incarnation.
+*/
+func (m *membership) apply(u *pb.MemberUpdate) (bool, bool) {
+	m.Lock()
+	defer m.Unlock()
+	if u.Address == m.self {
+		if !m.left && u.State != pb.MemberUpdate_ALIVE && u.Incarnation >= m.incarnation {
+			m.incarnation = u.Incarnation + 1
+			m.enqueue(&pb.MemberUpdate{Address: m.self, State: pb.MemberUpdate_ALIVE, Incarnation: m.incarnation})
+		}
+		return false, false
+	}
+	current, ok := m.members[u.Address]
+	if !ok {
+		if u.State == pb.MemberUpdate_DEAD {
+			return false, false
+		}
+		m.members[u.Address] = &member{state: u.State, incarnation: u.Incarnation, suspectedAt: time.Now()}
+		m.enqueue(u)
+		return true, false
+	}
+	if u.Incarnation < current.incarnation || (u.Incarnation == current.incarnation && u.State <= current.state) {
+		return false, false
+	}
+	wasDead := current.state == pb.MemberUpdate_DEAD
+	if u.State == pb.MemberUpdate_SUSPECT && current.state != pb.MemberUpdate_SUSPECT {
+		current.suspectedAt = time.Now()
+	}
+	current.state, current.incarnation = u.State, u.Incarnation
+	m.enqueue(u)
+	return wasDead && u.State != pb.MemberUpdate_DEAD, !wasDead && u.State == pb.MemberUpdate_DEAD
+}
+
+/*
+Builds an update giving a known member a new state at its current incarnation, nil for unknown members.
+*/
+func (m *membership) declare(address string, state pb.MemberUpdate_State) *pb.MemberUpdate {
+	m.Lock()
+	defer m.Unlock()
+	current, ok := m.members[address]
+	if !ok {
+		return nil
+	}
+	return &pb.MemberUpdate{Address: address, State: state, Incarnation: current.incarnation}
+}
+
+func (m *membership) enqueue(u *pb.MemberUpdate) {
+	for i, queued := range m.queue {
+		if queued.update.Address == u.Address {
+			m.queue = append(m.queue[:i], m.queue[i+1:]...)
+			break
+		}
+	}
+	m.queue = append(m.queue, &queuedUpdate{update: u})
+}
+
+/*
+Returns the updates to piggyback on the next message, least transmitted first.
+*/
+func (m *membership) piggyback() []*pb.MemberUpdate {
+	m.Lock()
+	defer m.Unlock()
+	limit := 3 * int(math.Ceil(math.Log2(float64(len(m.members)+2))))
+	sort.SliceStable(m.queue, func(i, j int) bool {
+		return m.queue[i].transmits < m.queue[j].transmits
+	})
+	var updates []*pb.MemberUpdate
+	kept := m.queue[:0]
+	for _, queued := range m.queue {
+		if len(updates) < maxPiggyback {
+			updates = append(updates, queued.update)
+			queued.transmits++
+		}
+		if queued.transmits < limit {
+			kept = append(kept, queued)
+		}
+	}
+	m.queue = kept
+	return updates
+}
+
+/*
+Returns the state of every member, this node included.
+*/
+func (m *membership) snapshot() []*pb.MemberUpdate {
+	m.Lock()
+	defer m.Unlock()
+	updates := []*pb.MemberUpdate{{Address: m.self, State: pb.MemberUpdate_ALIVE, Incarnation: m.incarnation}}
+	for address, current := range m.members {
+		updates = append(updates, &pb.MemberUpdate{Address: address, State: current.state, Incarnation: current.incarnation})
+	}
+	return updates
+}
+
+/*
+Picks the member to probe, going through the live members in a random order that is reshuffled every round.
+*/
+func (m *membership) nextTarget() string {
+	m.Lock()
+	defer m.Unlock()
+	for !m.left {
+		if len(m.order) == 0 {
+			for address, current := range m.members {
+				if current.state != pb.MemberUpdate_DEAD {
+					m.order = append(m.order, address)
+				}
+			}
+			if len(m.order) == 0 {
+				return ""
+			}
+			rand.Shuffle(len(m.order), func(i, j int) {
+				m.order[i], m.order[j] = m.order[j], m.order[i]
+			})
+		}
+		address := m.order[0]
+		m.order = m.order[1:]
+		if current, ok := m.members[address]; ok && current.state != pb.MemberUpdate_DEAD {
+			return address
+		}
+	}
+	return ""
+}
+
+/*
+Marks this node as leaving, and disseminates its departure as a DEAD update about itself.
+*/
+func (m *membership) leave() {
+	m.Lock()
+	defer m.Unlock()
+	m.left = true
+	m.enqueue(&pb.MemberUpdate{Address: m.self, State: pb.MemberUpdate_DEAD, Incarnation: m.incarnation})
+}
+
+/*
+Reports whether a check of the member may start, there is at most one at a time per member.
+*/
+func (m *membership) startCheck(address string) bool {
+	m.Lock()
+	defer m.Unlock()
+	if _, ok := m.checking[address]; ok {
+		return false
+	}
+	m.checking[address] = struct{}{}
+	return true
+}
+
+func (m *membership) endCheck(address string) {
+	m.Lock()
+	defer m.Unlock()
+	delete(m.checking, address)
+}
+
+/*
+Picks up to k alive members other than exclude, to probe it indirectly.
+*/
+func (m *membership) randomMembers(k int, exclude string) []string {
+	m.Lock()
+	defer m.Unlock()
+	var candidates []string
+	for address, current := range m.members {
+		if address != exclude && current.state == pb.MemberUpdate_ALIVE {
+			candidates = append(candidates, address)
+		}
+	}
+	rand.Shuffle(len(candidates), func(i, j int) {
+		candidates[i], candidates[j] = candidates[j], candidates[i]
+	})
+	if len(candidates) > k {
+		candidates = candidates[:k]
+	}
+	return candidates
+}
+
+/*
+Returns DEAD updates for the suspects that did not refute within suspicionTimeout.
+*/
+func (m *membership) expiredSuspects() []*pb.MemberUpdate {
+	m.Lock()
+	defer m.Unlock()
+	var updates []*pb.MemberUpdate
+	for address, current := range m.members {
+		if current.state == pb.MemberUpdate_SUSPECT && time.Since(current.suspectedAt) > suspicionTimeout {
+			updates = append(updates, &pb.MemberUpdate{Address: address, State: pb.MemberUpdate_DEAD, Incarnation: current.incarnation})
+		}
+	}
+	return updates
+}
+
+/* Answers a probe. Updates piggybacked by the prober are applied, and recent updates are sent back.
+A joining node asks for the whole membership with sync. Only peers may call it.
+*/
+func (s *Server) Ping(ctx context.Context, in *pb.PingRequest) (*pb.PingReply, error) {
+	if !s.fromPeer(ctx) {
+		return nil, errPeerOnly
+	}
+	s.applyUpdates(in.Updates)
+	if in.Sync {
+		return &pb.PingReply{Updates: s.membership.snapshot()}, nil
+	}
+	return &pb.PingReply{Updates: s.membership.piggyback()}, nil
+}
+
+/* Probes the target on behalf of a member that could not reach it directly. Only peers may call it.
+*/
+func (s *Server) PingReq(ctx context.Context, in *pb.PingReqRequest) (*pb.PingReply, error) {
+	if !s.fromPeer(ctx) {
+		return nil, errPeerOnly
+	}
+	s.applyUpdates(in.Updates)
+	ack := s.ping(in.Target, probeTimeout) == nil
+	return &pb.PingReply{Updates: s.membership.piggyback(), Ack: ack}, nil
+}
+
+/*
+Probes one member every protocolPeriod, and declares dead the suspects that did not refute in time.
+*/
+func (s *Server) gossip() {
+	for range time.Tick(protocolPeriod) {
+		if target := s.membership.nextTarget(); target != "" {
+			s.probe(target)
+		}
+		s.applyUpdates(s.membership.expiredSuspects())
+	}
+}
+
+/*
+Probes the target directly, then indirectly, and suspects it if neither probe reached it.
+*/
+func (s *Server) probe(target string) {
+	if s.ping(target, probeTimeout) != nil && !s.indirectProbe(target) {
+		s.applyUpdates([]*pb.MemberUpdate{s.membership.declare(target, pb.MemberUpdate_SUSPECT)})
+	}
+}
+
+func (s *Server) ping(address string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	reply, err := s.client.PingMember(ctx, address, &pb.PingRequest{Updates: s.membership.piggyback()})
+	if err == nil {
+		s.applyUpdates(reply.Updates)
+	}
+	return err
+}
+
+/*
+Asks indirectProbes other members to probe the target, so that a bad link between this node and the target
+does not get a healthy member suspected. Reports whether any of them reached it.
+*/
+func (s *Server) indirectProbe(target string) bool {
+	helpers := s.membership.randomMembers(indirectProbes, target)
+	ctx, cancel := context.WithTimeout(context.Background(), protocolPeriod)
+	defer cancel()
+	acks := make(chan bool, len(helpers))
+	for _, helper := range helpers {
+		go func(helper string) {
+			reply, err := s.client.PingReqMember(ctx, helper, &pb.PingReqRequest{Target: target, Updates: s.membership.piggyback()})
+			if err == nil {
+				s.applyUpdates(reply.Updates)
+			}
+			acks <- err == nil && reply.Ack
+		}(helper)
+	}
+	for range helpers {
+		select {
+		case ack := <-acks:
+			if ack {
+				return true
+			}
+		case <-ctx.Done():
+			return false
+		}
+	}
+	return false
+}
+
+/*
+Applies membership updates, and adds to or removes from the ring the members that joined or left.
+*/
+func (s *Server) applyUpdates(updates []*pb.MemberUpdate) {
+	for _, u := range updates {
+		if u == nil {
+			continue
+		}
+		joined, left := s.membership.apply(u)
+		if joined {
+			log.Printf("Member joined: %v", u.Address)
+			if s.addMember(u.Address) {
+				go func() {
+					if _, err := s.handOff(); err != nil {
+						log.Printf("Handoff failed: %v", err)
+					}
+				}()
+			}
+		} else if left {
+			log.Printf("Member left: %v", u.Address)
+			s.removeMember(u.Address)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package src
+
+import (
+	pb "drcache/grpc/definitions"
+	"net"
+	"testing"
+	"time"
+)
+
+func update(address string, state pb.MemberUpdate_State, incarnation uint64) *pb.MemberUpdate {
+	return &pb.MemberUpdate{Address: address, State: state, Incarnation: incarnation}
+}
+
+func TestMembershipApplyOrdering(t *testing.T) {
+	const (
+		alive   = pb.MemberUpdate_ALIVE
+		suspect = pb.MemberUpdate_SUSPECT
+		dead    = pb.MemberUpdate_DEAD
+	)
+	tests := []struct {
+		name    string
+		initial *pb.MemberUpdate // applied first, nil to leave the member unknown
+		update  *pb.MemberUpdate
+		state   pb.MemberUpdate_State
+		joined  bool
+		left    bool
+	}{
+		{"unknown member joins", nil, update("b", alive, 1), alive, true, false},
+		{"unknown dead member is ignored", nil, update("b", dead, 1), dead, false, false},
+		{"suspect overrides alive at same incarnation", update("b", alive, 1), update("b", suspect, 1), suspect, false, false},
+		{"alive does not override suspect at same incarnation", update("b", suspect, 1), update("b", alive, 1), suspect, false, false},
+		{"alive at higher incarnation refutes suspect", update("b", suspect, 1), update("b", alive, 2), alive, false, false},
+		{"dead overrides suspect at same incarnation", update("b", suspect, 1), update("b", dead, 1), dead, false, true},
+		{"older incarnation is ignored", update("b", alive, 2), update("b", dead, 1), alive, false, false},
+		{"stale alive does not revive dead member", update("b", dead, 2), update("b", alive, 2), dead, false, false},
+		{"restarted dead member rejoins", update("b", dead, 2), update("b", alive, 3), alive, true, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := newMembership("a", map[string]struct{}{"a": {}})
+			if test.initial != nil {
+				m.apply(update("b", alive, 0))
+				m.apply(test.initial)
+			}
+			joined, left := m.apply(test.update)
+			if joined != test.joined || left != test.left {
+				t.Errorf("apply reported joined %v left %v, want %v %v", joined, left, test.joined, test.left)
+			}
+			if current, ok := m.members["b"]; ok && current.state != test.state {
+				t.Errorf("state %v, want %v", current.state, test.state)
+			} else if !ok && test.state != dead {
+				t.Errorf("member unknown, want %v", test.state)
+			}
+		})
+	}
+}
+
+func TestMembershipRefutesSuspicion(t *testing.T) {
+	m := newMembership("a", map[string]struct{}{"a": {}, "b": {}})
+	incarnation := m.incarnation
+	m.apply(update("a", pb.MemberUpdate_SUSPECT, incarnation))
+	if m.incarnation <= incarnation {
+		t.Fatalf("incarnation %v not raised above %v", m.incarnation, incarnation)
+	}
+	for _, u := range m.piggyback() {
+		if u.Address == "a" {
+			if u.State != pb.MemberUpdate_ALIVE || u.Incarnation != m.incarnation {
+				t.Errorf("queued %v, want ALIVE at %v", u, m.incarnation)
+			}
+			return
+		}
+	}
+	t.Error("refutation not queued")
+}
+
+func isMember(s *Server, address string) bool {
+	s.members.Lock()
+	defer s.members.Unlock()
+	_, ok := s.serverList[address]
+	return ok
+}
+
+func TestGossipSpreadsJoin(t *testing.T) {
+	first, second := startCluster(t)
+	third := startNode(t)
+	// third announces itself to first only
+	if _, err := first.Ping(peerContext(), &pb.PingRequest{Updates: third.membership.snapshot()}); err != nil {
+		t.Fatal(err)
+	}
+	if !isMember(first.Server, third.selfAddress) {
+		t.Fatal("first did not add the joined node to its ring")
+	}
+	// second learns about it from the updates piggybacked on the reply to its next probe of first
+	if err := second.ping(first.selfAddress, time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if !isMember(second.Server, third.selfAddress) {
+		t.Error("the join did not reach second")
+	}
+}
+
+func TestIndirectProbe(t *testing.T) {
+	first, second := startCluster(t)
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	closed := lis.Addr().String()
+	lis.Close()
+	ctx := peerContext()
+	if reply, err := second.PingReq(ctx, &pb.PingReqRequest{Target: first.selfAddress}); err != nil || !reply.Ack {
+		t.Errorf("probe of a live member returned %v, %v", reply, err)
+	}
+	if reply, err := second.PingReq(ctx, &pb.PingReqRequest{Target: closed}); err != nil || reply.Ack {
+		t.Errorf("probe of a closed address returned %v, %v", reply, err)
+	}
+}
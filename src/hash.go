@@ -0,0 +1,227 @@
+package src
+
+import (
+	"context"
+	pb "drcache/grpc/definitions"
+	"encoding/binary"
+	"google.golang.org/grpc/status"
+	"log"
+	"math"
+	"sort"
+	"strconv"
+)
+
+/* With consistent hashing check if key belongs to you, if so update the hash in local cache. Otherwise send to other server with client
+Sets the given fields of the hash, creating the hash if it does not exist. The reply counts the fields that were added.
+*/
+func (s *Server) HSet(ctx context.Context, in *pb.HashSetRequest) (*pb.HashReply, error) {
+	log.Printf("Received: %v", in.Key)
+	nodeAddress := s.ring().Get(in.Key)
+	if nodeAddress == s.selfAddress {
+		var added uint32
+		item, err := s.updateKind(in.Namespace, in.Key, pb.Kind_HASH, in.Expiration, func(raw []byte) ([]byte, error) {
+			fields, err := decodeHash(raw)
+			if err != nil {
+				return nil, err
+			}
+			for field, value := range in.Fields {
+				if _, ok := fields[field]; !ok {
+					added++
+				}
+				fields[field] = value
+			}
+			return encodeHash(fields), nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &pb.HashReply{LastUpdate: item.LastUpdate, Count: added}, nil
+	} else {
+		reply, err := s.client.HSetItem(nodeAddress, in)
+		if status.Code(err) == 14 { // Connection Error server is down
+			s.suspectServer(nodeAddress)
+		}
+		return reply, err
+	}
+}
+
+/* With consistent hashing check if key belongs to you, if so read the hash from local cache. Otherwise send to other server with client
+Returns the given fields that exist, a missing hash has no fields.
+*/
+func (s *Server) HGet(ctx context.Context, in *pb.HashGetRequest) (*pb.HashReply, error) {
+	nodeAddress := s.ring().Get(in.Key)
+	if nodeAddress == s.selfAddress {
+		fields, lastUpdate, err := s.readHash(in.Namespace, in.Key)
+		if err != nil {
+			return nil, err
+		}
+		reply := &pb.HashReply{Fields: make(map[string][]byte), LastUpdate: lastUpdate}
+		for _, field := range in.Fields {
+			if value, ok := fields[field]; ok {
+				reply.Fields[field] = value
+			}
+		}
+		return reply, nil
+	} else {
+		reply, err := s.client.HGetItem(nodeAddress, in)
+		if status.Code(err) == 14 { // Connection Error server is down
+			s.suspectServer(nodeAddress)
+		}
+		return reply, err
+	}
+}
+
+/* With consistent hashing check if key belongs to you, if so read the hash from local cache. Otherwise send to other server with client
+Returns every field of the hash, a missing hash has no fields.
+*/
+func (s *Server) HGetAll(ctx context.Context, in *pb.HashGetRequest) (*pb.HashReply, error) {
+	nodeAddress := s.ring().Get(in.Key)
+	if nodeAddress == s.selfAddress {
+		fields, lastUpdate, err := s.readHash(in.Namespace, in.Key)
+		if err != nil {
+			return nil, err
+		}
+		return &pb.HashReply{Fields: fields, LastUpdate: lastUpdate}, nil
+	} else {
+		reply, err := s.client.HGetAllItem(nodeAddress, in)
+		if status.Code(err) == 14 { // Connection Error server is down
+			s.suspectServer(nodeAddress)
+		}
+		return reply, err
+	}
+}
+
+/* With consistent hashing check if key belongs to you, if so update the hash in local cache. Otherwise send to other server with client
+Removes the given fields, the hash is deleted with its last field. The reply counts the fields that were removed.
+*/
+func (s *Server) HDel(ctx context.Context, in *pb.HashGetRequest) (*pb.HashReply, error) {
+	log.Printf("Received: %v", in.Key)
+	nodeAddress := s.ring().Get(in.Key)
+	if nodeAddress == s.selfAddress {
+		var removed uint32
+		item, err := s.updateKind(in.Namespace, in.Key, pb.Kind_HASH, 0, func(raw []byte) ([]byte, error) {
+			fields, err := decodeHash(raw)
+			if err != nil {
+				return nil, err
+			}
+			for _, field := range in.Fields {
+				if _, ok := fields[field]; ok {
+					delete(fields, field)
+					removed++
+				}
+			}
+			return encodeHash(fields), nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &pb.HashReply{LastUpdate: item.LastUpdate, Count: removed}, nil
+	} else {
+		reply, err := s.client.HDelItem(nodeAddress, in)
+		if status.Code(err) == 14 { // Connection Error server is down
+			s.suspectServer(nodeAddress)
+		}
+		return reply, err
+	}
+}
+
+/* With consistent hashing check if key belongs to you, if so update the hash in local cache. Otherwise send to other server with client
+Adds the delta to a field holding a decimal 64-bit integer, a missing field or hash starts from 0.
+*/
+func (s *Server) HIncr(ctx context.Context, in *pb.HashIncrRequest) (*pb.HashReply, error) {
+	log.Printf("Received: %v", in.Key)
+	nodeAddress := s.ring().Get(in.Key)
+	if nodeAddress == s.selfAddress {
+		var value int64
+		item, err := s.updateKind(in.Namespace, in.Key, pb.Kind_HASH, in.Expiration, func(raw []byte) ([]byte, error) {
+			fields, err := decodeHash(raw)
+			if err != nil {
+				return nil, err
+			}
+			if stored, ok := fields[in.Field]; ok {
+				value, err = strconv.ParseInt(string(stored), 10, 64)
+				if err != nil {
+					return nil, errNotInteger
+				}
+			}
+			if (in.Delta > 0 && value > math.MaxInt64-in.Delta) || (in.Delta < 0 && value < math.MinInt64-in.Delta) {
+				return nil, errCounterOverflow
+			}
+			value += in.Delta
+			fields[in.Field] = []byte(strconv.FormatInt(value, 10))
+			return encodeHash(fields), nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &pb.HashReply{LastUpdate: item.LastUpdate, Value: value}, nil
+	} else {
+		reply, err := s.client.HIncrItem(nodeAddress, in)
+		if status.Code(err) == 14 { // Connection Error server is down
+			s.suspectServer(nodeAddress)
+		}
+		return reply, err
+	}
+}
+
+func (s *Server) readHash(ns string, key string) (map[string][]byte, uint64, error) {
+	item, err := s.readKind(ns, key, pb.Kind_HASH)
+	if err == ErrNotFound {
+		return make(map[string][]byte), 0, nil
+	} else if err != nil {
+		return nil, 0, err
+	}
+	fields, err := decodeHash(item.Value)
+	return fields, item.LastUpdate, err
+}
+
+/*
+Layout: for every field, in field order, its length (uvarint), its bytes, the value's length (uvarint) and bytes.
+An empty hash encodes to nothing, so that updateKind deletes it.
+*/
+func encodeHash(fields map[string][]byte) []byte {
+	names := make([]string, 0, len(fields))
+	size := 0
+	for name, value := range fields {
+		names = append(names, name)
+		size += 2*binary.MaxVarintLen64 + len(name) + len(value)
+	}
+	sort.Strings(names)
+	buf := make([]byte, size)
+	n := 0
+	for _, name := range names {
+		n += binary.PutUvarint(buf[n:], uint64(len(name)))
+		n += copy(buf[n:], name)
+		n += binary.PutUvarint(buf[n:], uint64(len(fields[name])))
+		n += copy(buf[n:], fields[name])
+	}
+	return buf[:n]
+}
+
+func decodeHash(raw []byte) (map[string][]byte, error) {
+	fields := make(map[string][]byte)
+	for len(raw) > 0 {
+		name, rest, err := readChunk(raw)
+		if err != nil {
+			return nil, err
+		}
+		value, rest, err := readChunk(rest)
+		if err != nil {
+			return nil, err
+		}
+		fields[string(name)] = value
+		raw = rest
+	}
+	return fields, nil
+}
+
+/*
+Reads a uvarint length prefixed chunk, returning it and the bytes after it.
+*/
+func readChunk(raw []byte) ([]byte, []byte, error) {
+	length, n := binary.Uvarint(raw)
+	if n <= 0 || uint64(len(raw)-n) < length {
+		return nil, nil, errCorruptEntry
+	}
+	return raw[n : n+int(length)], raw[n+int(length):], nil
+}
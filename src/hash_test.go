@@ -0,0 +1,102 @@
+package src
+
+import (
+	"context"
+	pb "drcache/grpc/definitions"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestHashFields(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	reply, err := s.HSet(ctx, &pb.HashSetRequest{Key: "user", Fields: map[string][]byte{"name": []byte("ada"), "age": []byte("36")}})
+	if err != nil || reply.Count != 2 {
+		t.Fatalf("HSet of a new hash returned %v, %v", reply, err)
+	}
+	if reply, err = s.HSet(ctx, &pb.HashSetRequest{Key: "user", Fields: map[string][]byte{"name": []byte("grace"), "city": []byte("nyc")}}); err != nil || reply.Count != 1 {
+		t.Errorf("HSet counted %v added fields, %v", reply.GetCount(), err)
+	}
+	reply, err = s.HGet(ctx, &pb.HashGetRequest{Key: "user", Fields: []string{"name", "missing"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string][]byte{"name": []byte("grace")}; !reflect.DeepEqual(reply.Fields, want) {
+		t.Errorf("HGet returned %q, want %q", reply.Fields, want)
+	}
+	reply, err = s.HGetAll(ctx, &pb.HashGetRequest{Key: "user"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string][]byte{"name": []byte("grace"), "age": []byte("36"), "city": []byte("nyc")}; !reflect.DeepEqual(reply.Fields, want) {
+		t.Errorf("HGetAll returned %q, want %q", reply.Fields, want)
+	}
+
+	if reply, err = s.HDel(ctx, &pb.HashGetRequest{Key: "user", Fields: []string{"age", "missing"}}); err != nil || reply.Count != 1 {
+		t.Errorf("HDel counted %v removed fields, %v", reply.GetCount(), err)
+	}
+	if _, err = s.HDel(ctx, &pb.HashGetRequest{Key: "user", Fields: []string{"name", "city"}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := getString(t, s, "user"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("hash without fields was kept: %v", err)
+	}
+	if reply, err = s.HGetAll(ctx, &pb.HashGetRequest{Key: "user"}); err != nil || len(reply.Fields) != 0 {
+		t.Errorf("HGetAll of a missing hash returned %v, %v", reply, err)
+	}
+}
+
+func TestHashIncr(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	reply, err := s.HIncr(ctx, &pb.HashIncrRequest{Key: "stats", Field: "views", Delta: 3})
+	if err != nil || reply.Value != 3 {
+		t.Fatalf("HIncr of a missing hash returned %v, %v", reply, err)
+	}
+	if reply, err = s.HIncr(ctx, &pb.HashIncrRequest{Key: "stats", Field: "views", Delta: -5}); err != nil || reply.Value != -2 {
+		t.Errorf("HIncr returned %v, %v", reply, err)
+	}
+	if _, err := s.HSet(ctx, &pb.HashSetRequest{Key: "stats", Fields: map[string][]byte{"name": []byte("home")}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.HIncr(ctx, &pb.HashIncrRequest{Key: "stats", Field: "name", Delta: 1}); !errors.Is(err, errNotInteger) {
+		t.Errorf("HIncr of text returned %v", err)
+	}
+	if reply, err = s.HGet(ctx, &pb.HashGetRequest{Key: "stats", Fields: []string{"views"}}); err != nil || string(reply.Fields["views"]) != "-2" {
+		t.Errorf("stored field is %v, %v", reply, err)
+	}
+}
+
+func TestHashWrongKind(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	setString(t, s, "text", "value")
+	if _, err := s.HSet(ctx, &pb.HashSetRequest{Key: "text", Fields: map[string][]byte{"field": nil}}); !errors.Is(err, errWrongKind) {
+		t.Errorf("HSet of a string returned %v", err)
+	}
+	if _, err := s.HGetAll(ctx, &pb.HashGetRequest{Key: "text"}); !errors.Is(err, errWrongKind) {
+		t.Errorf("HGetAll of a string returned %v", err)
+	}
+	if _, err := s.HSet(ctx, &pb.HashSetRequest{Key: "hash", Fields: map[string][]byte{"field": nil}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Set(ctx, &pb.SetRequest{Item: &pb.Item{Key: "hash", Value: []byte("value")}}); !errors.Is(err, errWrongKind) {
+		t.Errorf("Set over a hash returned %v", err)
+	}
+}
+
+func TestHashEncoding(t *testing.T) {
+	fields := map[string][]byte{"name": []byte("drcache"), "empty": {}, "": []byte("unnamed")}
+	raw := encodeHash(fields)
+	decoded, err := decodeHash(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded, fields) {
+		t.Errorf("decoded %q, want %q", decoded, fields)
+	}
+	if _, err := decodeHash(raw[:len(raw)-1]); err != errCorruptEntry {
+		t.Errorf("truncated hash decoded with %v", err)
+	}
+}
@@ -0,0 +1,239 @@
+package src
+
+import (
+	"context"
+	pb "drcache/grpc/definitions"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"hash/fnv"
+	"log"
+	"math"
+	"math/bits"
+)
+
+const (
+	hllMinPrecision = 4
+	hllMaxPrecision = 14 // 16KB of registers, one byte each
+)
+
+/*
+Sketches use the highest precision whose registers take at most half of the largest entry of the namespace, the rest
+is left for the key. All sketches of a namespace have as many registers, so that they merge.
+The standard error is 1.04/sqrt(registers): 9% in a 512KB namespace, 1.6% from 16MB and 0.8% from 64MB.
+*/
+func hllRegisters(space *namespace) int {
+	registers := 1 << hllMaxPrecision
+	for registers > 1<<hllMinPrecision && registers > space.maxEntrySize()/2 {
+		registers >>= 1
+	}
+	return registers
+}
+
+/* With consistent hashing check if key belongs to you, if so add to the sketch in local cache. Otherwise send to other server with client
+Adds the elements to the HyperLogLog sketch, creating the sketch if it does not exist.
+*/
+func (s *Server) PFAdd(ctx context.Context, in *pb.HyperLogLogAddRequest) (*pb.HyperLogLogReply, error) {
+	log.Printf("Received: %v", in.Key)
+	nodeAddress := s.ring().Get(in.Key)
+	if nodeAddress == s.selfAddress {
+		space, err := s.namespaces.get(in.Namespace)
+		if err != nil {
+			return nil, err
+		}
+		size := hllRegisters(space)
+		reply := &pb.HyperLogLogReply{}
+		_, err = s.updateKind(in.Namespace, in.Key, pb.Kind_HYPERLOGLOG, in.Expiration, func(registers []byte) ([]byte, error) {
+			if registers == nil {
+				registers = make([]byte, size)
+				reply.Changed = true
+			} else if len(registers) != size {
+				return nil, errCorruptEntry
+			}
+			for _, element := range in.Elements {
+				index, rank := hllRegister(hash64(element), size)
+				if registers[index] < rank {
+					registers[index] = rank
+					reply.Changed = true
+				}
+			}
+			return registers, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return reply, nil
+	} else {
+		reply, err := s.client.PFAddItem(nodeAddress, in)
+		if status.Code(err) == 14 { // Connection Error server is down
+			s.suspectServer(nodeAddress)
+		}
+		return reply, err
+	}
+}
+
+/* Estimates the number of distinct elements added to any of the sketches.
+Sketches are fetched from their owners and merged by the receiving node. Missing keys count as empty sketches.
+*/
+func (s *Server) PFCount(ctx context.Context, in *pb.HyperLogLogCountRequest) (*pb.HyperLogLogReply, error) {
+	registers, err := s.mergeSketches(in.Namespace, in.Keys)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.HyperLogLogReply{Count: hllEstimate(registers)}, nil
+}
+
+/* With consistent hashing check if destination belongs to you, if so merge into the sketch in local cache. Otherwise send to other server with client
+The sources are fetched from their owners, then merged with the destination under its key lock.
+*/
+func (s *Server) PFMerge(ctx context.Context, in *pb.HyperLogLogMergeRequest) (*pb.HyperLogLogReply, error) {
+	log.Printf("Received: %v", in.Destination)
+	nodeAddress := s.ring().Get(in.Destination)
+	if nodeAddress == s.selfAddress {
+		sources, err := s.mergeSketches(in.Namespace, in.Sources)
+		if err != nil {
+			return nil, err
+		}
+		reply := &pb.HyperLogLogReply{}
+		_, err = s.updateKind(in.Namespace, in.Destination, pb.Kind_HYPERLOGLOG, in.Expiration, func(registers []byte) ([]byte, error) {
+			if registers == nil {
+				registers = make([]byte, len(sources))
+			} else if len(registers) != len(sources) {
+				return nil, errCorruptEntry
+			}
+			mergeRegisters(registers, sources)
+			reply.Count = hllEstimate(registers)
+			return registers, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return reply, nil
+	} else {
+		reply, err := s.client.PFMergeItem(nodeAddress, in)
+		if status.Code(err) == 14 { // Connection Error server is down
+			s.suspectServer(nodeAddress)
+		}
+		return reply, err
+	}
+}
+
+/*
+Returns the union of the sketches stored under keys, wherever they live.
+*/
+func (s *Server) mergeSketches(ns string, keys []string) ([]byte, error) {
+	space, err := s.namespaces.get(ns)
+	if err != nil {
+		return nil, err
+	}
+	registers := make([]byte, hllRegisters(space))
+	for _, key := range keys {
+		sketch, err := s.fetchKind(ns, key, pb.Kind_HYPERLOGLOG)
+		if err != nil {
+			return nil, err
+		}
+		if sketch == nil {
+			continue
+		}
+		if len(sketch) != len(registers) {
+			return nil, errCorruptEntry
+		}
+		mergeRegisters(registers, sketch)
+	}
+	return registers, nil
+}
+
+/*
+Reads the value of a data type from the key's owner, through Client if it is another node. A missing key has a nil value.
+*/
+func (s *Server) fetchKind(ns string, key string, kind pb.Kind) ([]byte, error) {
+	nodeAddress := s.ring().Get(key)
+	var item *pb.Item
+	var err error
+	if nodeAddress == s.selfAddress {
+		item, err = s.getLocal(ns, key)
+	} else {
+		var reply *pb.Reply
+		reply, err = s.client.GetItem(nodeAddress, &pb.GetRequest{Key: key, Namespace: ns})
+		if status.Code(err) == 14 { // Connection Error server is down
+			s.suspectServer(nodeAddress)
+		}
+		if err == nil {
+			item = reply.Item
+		}
+	}
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if item.Kind != kind {
+		return nil, errWrongKind
+	}
+	return item.Value, nil
+}
+
+func mergeRegisters(into []byte, from []byte) {
+	for i, rank := range from {
+		if into[i] < rank {
+			into[i] = rank
+		}
+	}
+}
+
+/*
+The first bits of the hash pick one of registers, a power of two, the rank is the position of the first set bit in the rest.
+*/
+func hllRegister(hash uint64, registers int) (uint32, byte) {
+	precision := uint(bits.TrailingZeros(uint(registers)))
+	index := uint32(hash >> (64 - precision))
+	rest := hash<<precision | 1<<(precision-1)
+	return index, byte(bits.LeadingZeros64(rest) + 1)
+}
+
+/*
+Raw HyperLogLog estimate, with linear counting while many registers are still empty.
+The 64-bit hash makes the large range correction unnecessary.
+*/
+func hllEstimate(registers []byte) uint64 {
+	m := float64(len(registers))
+	sum := 0.0
+	zeros := 0
+	for _, rank := range registers {
+		sum += math.Ldexp(1, -int(rank))
+		if rank == 0 {
+			zeros++
+		}
+	}
+	alpha := 0.7213 / (1 + 1.079/m)
+	switch len(registers) {
+	case 16:
+		alpha = 0.673
+	case 32:
+		alpha = 0.697
+	case 64:
+		alpha = 0.709
+	}
+	estimate := alpha * m * m / sum
+	if estimate <= 2.5*m && zeros > 0 {
+		estimate = m * math.Log(m/float64(zeros))
+	}
+	return uint64(estimate + 0.5)
+}
+
+/*
+FNV-1a followed by the murmur3 finalizer, so that every bit of the result depends on every byte of the input.
+*/
+func hash64(data []byte) uint64 {
+	h := fnv.New64a()
+	h.Write(data)
+	return mix64(h.Sum64())
+}
+
+func mix64(x uint64) uint64 {
+	x ^= x >> 33
+	x *= 0xff51afd7ed558ccd
+	x ^= x >> 33
+	x *= 0xc4ceb9fe1a85ec53
+	x ^= x >> 33
+	return x
+}
@@ -0,0 +1,123 @@
+package src
+
+import (
+	"context"
+	pb "drcache/grpc/definitions"
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+func pfAdd(t *testing.T, node *testNode, key string, from int, to int) {
+	t.Helper()
+	in := &pb.HyperLogLogAddRequest{Key: key}
+	for i := from; i < to; i++ {
+		in.Elements = append(in.Elements, []byte(fmt.Sprint("element-", i)))
+	}
+	if _, err := node.PFAdd(context.Background(), in); err != nil {
+		t.Fatalf("PFAdd to %v: %v", key, err)
+	}
+}
+
+/*
+The count must be within 4 standard errors of a sketch sized for the namespace.
+*/
+func checkCount(t *testing.T, count uint64, want int) {
+	t.Helper()
+	if math.Abs(float64(count)-float64(want)) > 4*1.04/math.Sqrt(128)*float64(want) {
+		t.Errorf("counted %v distinct elements, want about %v", count, want)
+	}
+}
+
+func TestPFCountAcrossNodes(t *testing.T) {
+	first, second := startCluster(t)
+	ctx := context.Background()
+	local := keyOwnedBy(t, first.Server, first.selfAddress, "visitors-")
+	remote := keyOwnedBy(t, first.Server, second.selfAddress, "visitors-")
+	pfAdd(t, first, local, 0, 600)
+	pfAdd(t, first, remote, 400, 1000)
+
+	reply, err := first.PFCount(ctx, &pb.HyperLogLogCountRequest{Keys: []string{local, remote, "missing"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkCount(t, reply.Count, 1000)
+	if reply, err = second.PFMerge(ctx, &pb.HyperLogLogMergeRequest{Destination: "union", Sources: []string{local, remote}}); err != nil {
+		t.Fatal(err)
+	}
+	checkCount(t, reply.Count, 1000)
+	if reply, err = first.PFCount(ctx, &pb.HyperLogLogCountRequest{Keys: []string{"union"}}); err != nil {
+		t.Fatal(err)
+	}
+	checkCount(t, reply.Count, 1000)
+
+	setString(t, first.Server, local+"-text", "value")
+	if _, err := first.PFCount(ctx, &pb.HyperLogLogCountRequest{Keys: []string{local + "-text"}}); !errors.Is(err, errWrongKind) {
+		t.Errorf("PFCount of a string returned %v", err)
+	}
+}
+
+func TestPFAddReportsChange(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	in := &pb.HyperLogLogAddRequest{Key: "visitors", Elements: [][]byte{[]byte("a")}}
+	if reply, err := s.PFAdd(ctx, in); err != nil || !reply.Changed {
+		t.Errorf("first PFAdd returned %v, %v", reply, err)
+	}
+	if reply, err := s.PFAdd(ctx, in); err != nil || reply.Changed {
+		t.Errorf("PFAdd of a known element returned %v, %v", reply, err)
+	}
+}
+
+func TestHLLRegistersFitNamespace(t *testing.T) {
+	tests := []struct {
+		quota     uint64
+		registers int
+	}{
+		{minNamespaceQuota, 128},
+		{16 << 20, 4096},
+		{64 << 20, 1 << hllMaxPrecision},
+		{maxNamespaceQuota, 1 << hllMaxPrecision},
+	}
+	for _, test := range tests {
+		space := &namespace{quota: test.quota}
+		registers := hllRegisters(space)
+		if registers != test.registers {
+			t.Errorf("hllRegisters(%v) = %v, want %v", test.quota, registers, test.registers)
+		}
+		if !space.fits("a key of forty bytes, a common key length", registers) {
+			t.Errorf("a sketch of %v registers does not fit a %v bytes namespace", registers, test.quota)
+		}
+	}
+}
+
+func TestHLLEstimate(t *testing.T) {
+	for _, size := range []int{128, 1 << hllMaxPrecision} {
+		stdError := 1.04 / math.Sqrt(float64(size))
+		for _, count := range []int{0, 10, 1000, 100000} {
+			registers := make([]byte, size)
+			for i := 0; i < count; i++ {
+				index, rank := hllRegister(hash64([]byte(fmt.Sprint("element-", i))), size)
+				if registers[index] < rank {
+					registers[index] = rank
+				}
+			}
+			estimate := float64(hllEstimate(registers))
+			// 4 standard errors, and a little slack for small counts where linear counting is used
+			if math.Abs(estimate-float64(count)) > 4*stdError*float64(count)+2 {
+				t.Errorf("%v registers estimate %v elements as %v", size, count, estimate)
+			}
+		}
+	}
+}
+
+func TestMergeRegisters(t *testing.T) {
+	into := []byte{0, 3, 1, 5}
+	mergeRegisters(into, []byte{2, 1, 1, 6})
+	for i, want := range []byte{2, 3, 1, 6} {
+		if into[i] != want {
+			t.Fatalf("register %v is %v, want %v", i, into[i], want)
+		}
+	}
+}
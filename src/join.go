@@ -0,0 +1,155 @@
+package src
+
+import (
+	"context"
+	"crypto/subtle"
+	pb "drcache/grpc/definitions"
+	"errors"
+	"fmt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+var errNoSeed = &Error{codes.Unavailable, "NO_SEED", "None of the seeds could be reached."}
+var errJoining = &Error{codes.Unavailable, "JOINING", "Server is joining the cluster."}
+var errPeerOnly = &Error{codes.PermissionDenied, "PEER_ONLY", "Only members of the cluster may call this."}
+
+const joinTimeout = 5 * time.Second
+
+// Set to the cluster key by the Client of every member, so that a joining node serves its peers before it serves clients,
+// and only peers may hand over keys or announce themselves.
+const peerMetadataKey = "drcache-peer"
+
+/*
+Joins the cluster through the first seed that answers, then starts serving clients. Without seeds the node
+starts a new cluster.
+The seed sends the current ServerList and namespaces. The node creates the namespaces, then announces itself
+to every member with AddServer. Members reply once they have handed over the keys the node now owns, so when Join
+returns the node holds all of its key ranges. The node finally syncs its gossip membership with the seed.
+Join fails if a namespace can not be created, or a member could not hand over keys of a namespace.
+*/
+func (s *Server) Join(seeds []string) error {
+	tried := false
+	for _, seed := range seeds {
+		if seed == s.selfAddress {
+			continue
+		}
+		tried = true
+		list, err := s.client.GetServers(seed)
+		if err != nil {
+			log.Printf("Seed %v did not answer: %v", seed, err)
+			continue
+		}
+		for name, quota := range list.Namespaces {
+			if err := s.namespaces.create(name, quota); err != nil {
+				return fmt.Errorf("namespace %q: %w", name, err)
+			}
+		}
+		if err := s.announce(list); err != nil {
+			return err
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), joinTimeout)
+		reply, err := s.client.PingMember(ctx, seed, &pb.PingRequest{Updates: s.membership.snapshot(), Sync: true})
+		cancel()
+		if err == nil {
+			s.applyUpdates(reply.Updates)
+		}
+		atomic.StoreInt32(&s.ready, 1)
+		return nil
+	}
+	if tried {
+		return errNoSeed
+	}
+	atomic.StoreInt32(&s.ready, 1)
+	return nil
+}
+
+/*
+Adds the members of the list to the ring and calls AddServer on all of them in parallel. A member that can not
+be reached is left to the failure detection of the gossip. Returns the error of a member that lacked a namespace.
+*/
+func (s *Server) announce(list *pb.ServerList) error {
+	for _, address := range list.Servers {
+		s.applyUpdates([]*pb.MemberUpdate{{Address: address, State: pb.MemberUpdate_ALIVE}})
+	}
+	var missing error
+	var firstMissing sync.Once
+	var wg sync.WaitGroup
+	for _, address := range list.Servers {
+		if address == s.selfAddress {
+			continue
+		}
+		wg.Add(1)
+		go func(address string) {
+			defer wg.Done()
+			_, err := s.client.AddServer(address, &pb.AddServerRequest{Address: s.selfAddress})
+			if errors.Is(err, errNamespaceNotFound) {
+				firstMissing.Do(func() {
+					missing = err
+				})
+			} else if err != nil {
+				log.Printf("Could not announce to %v: %v", address, err)
+			}
+		}(address)
+	}
+	wg.Wait()
+	return missing
+}
+
+/*
+GateUnary and GateStream turn clients away until the node has joined the cluster, while its peers are served.
+They count requests as CountUnary and CountStream do, install them in their place on the grpc.Server serving s.
+*/
+func (s *Server) GateUnary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := s.serving(ctx); err != nil {
+		return nil, err
+	}
+	return s.CountUnary(ctx, req, info, handler)
+}
+
+func (s *Server) GateStream(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := s.serving(stream.Context()); err != nil {
+		return err
+	}
+	return s.CountStream(srv, stream, info, handler)
+}
+
+func (s *Server) serving(ctx context.Context) error {
+	if atomic.LoadInt32(&s.ready) == 1 {
+		return nil
+	}
+	if s.fromPeer(ctx) {
+		return nil
+	}
+	return errJoining
+}
+
+/*
+Reports whether the request carries the cluster key, which only the members' own Client sends.
+A node without a cluster key has no peers.
+*/
+func (s *Server) fromPeer(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || s.clusterKey == "" {
+		return false
+	}
+	for _, key := range md.Get(peerMetadataKey) {
+		if subtle.ConstantTimeCompare([]byte(key), []byte(s.clusterKey)) == 1 {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Client) peerUnary(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return invoker(metadata.AppendToOutgoingContext(ctx, peerMetadataKey, c.clusterKey), method, req, reply, cc, opts...)
+}
+
+func (c *Client) peerStream(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return streamer(metadata.AppendToOutgoingContext(ctx, peerMetadataKey, c.clusterKey), desc, cc, method, opts...)
+}
@@ -0,0 +1,122 @@
+package src
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+const leaseTTL = 10 * time.Second
+
+type lease struct {
+	token   uint64
+	expires time.Time
+}
+
+/*
+Leases as described in "Scaling Memcache at Facebook". A miss hands a lease to one caller, and only a Set
+carrying that lease fills the key. A Delete or another write of the key revokes the lease, so a slow
+recomputation can not overwrite the key with data that was invalidated meanwhile.
+Leases are keyed by namespacedKey.
+*/
+type leases struct {
+	leases    map[string]*lease
+	loads     map[string]struct{} // keys a loader is reading, dropped by a write or delete of the key
+	lastToken uint64
+	sync.Mutex
+}
+
+func newLeases() *leases {
+	return &leases{leases: make(map[string]*lease), loads: make(map[string]struct{}), lastToken: uint64(time.Now().UnixNano())}
+}
+
+/*
+Returns a new lease for the key, or ErrLeaseHeld if another caller holds a valid one.
+*/
+func (l *leases) grant(key string) (uint64, error) {
+	l.Lock()
+	defer l.Unlock()
+	if current, ok := l.leases[key]; ok && time.Now().Before(current.expires) {
+		return 0, ErrLeaseHeld
+	}
+	l.lastToken++
+	l.leases[key] = &lease{token: l.lastToken, expires: time.Now().Add(leaseTTL)}
+	return l.lastToken, nil
+}
+
+/*
+Reports whether token is the key's valid lease, and uses it up if so.
+*/
+func (l *leases) consume(key string, token uint64) bool {
+	l.Lock()
+	defer l.Unlock()
+	current, ok := l.leases[key]
+	if !ok || current.token != token || time.Now().After(current.expires) {
+		return false
+	}
+	delete(l.leases, key)
+	return true
+}
+
+func (l *leases) revoke(key string) {
+	l.Lock()
+	defer l.Unlock()
+	delete(l.leases, key)
+	delete(l.loads, key)
+}
+
+/*
+Guards a load of the key, as a lease guards a fill by a client, but without excluding other callers.
+Loads of a key are deduplicated, so there is at most one per key.
+*/
+func (l *leases) startLoad(key string) {
+	l.Lock()
+	defer l.Unlock()
+	l.loads[key] = struct{}{}
+}
+
+/*
+Reports whether the key was neither written nor deleted since startLoad, and ends the load.
+*/
+func (l *leases) endLoad(key string) bool {
+	l.Lock()
+	defer l.Unlock()
+	_, ok := l.loads[key]
+	delete(l.loads, key)
+	return ok
+}
+
+/*
+Revokes the leases of every key of the namespace, when it is flushed.
+*/
+func (l *leases) revokeNamespace(ns string) {
+	l.Lock()
+	defer l.Unlock()
+	prefix := namespacedKey(ns, "")
+	for key := range l.leases {
+		if strings.HasPrefix(key, prefix) {
+			delete(l.leases, key)
+		}
+	}
+	for key := range l.loads {
+		if strings.HasPrefix(key, prefix) {
+			delete(l.loads, key)
+		}
+	}
+}
+
+/*
+Drops expired leases of misses that were never filled.
+*/
+func (l *leases) expire() {
+	for range time.Tick(leaseTTL) {
+		l.Lock()
+		now := time.Now()
+		for key, current := range l.leases {
+			if now.After(current.expires) {
+				delete(l.leases, key)
+			}
+		}
+		l.Unlock()
+	}
+}
@@ -0,0 +1,72 @@
+package src
+
+import (
+	"context"
+	pb "drcache/grpc/definitions"
+	"errors"
+	"testing"
+)
+
+func getLease(t *testing.T, s *Server, key string) uint64 {
+	t.Helper()
+	reply, err := s.Get(context.Background(), &pb.GetRequest{Key: key, Lease: true})
+	if err != nil {
+		t.Fatalf("get %v with a lease: %v", key, err)
+	}
+	if reply.Lease == 0 {
+		t.Fatalf("miss of %v got no lease", key)
+	}
+	return reply.Lease
+}
+
+func setLeased(s *Server, key string, value string, lease uint64) error {
+	_, err := s.Set(context.Background(), &pb.SetRequest{Item: &pb.Item{Key: key, Value: []byte(value)}, Lease: lease})
+	return err
+}
+
+func TestLeaseFillsMiss(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	lease := getLease(t, s, "key")
+	if _, err := s.Get(ctx, &pb.GetRequest{Key: "key", Lease: true}); !errors.Is(err, ErrLeaseHeld) {
+		t.Errorf("second miss returned %v", err)
+	}
+	if err := setLeased(s, "key", "filled", lease+1); !errors.Is(err, ErrLeaseInvalid) {
+		t.Errorf("set with another token returned %v", err)
+	}
+	if err := setLeased(s, "key", "filled", lease); err != nil {
+		t.Fatalf("set with the lease: %v", err)
+	}
+	if value, err := getString(t, s, "key"); err != nil || value != "filled" {
+		t.Errorf("key reads %q, %v", value, err)
+	}
+	if err := setLeased(s, "key", "again", lease); !errors.Is(err, ErrLeaseInvalid) {
+		t.Errorf("lease was used twice: %v", err)
+	}
+}
+
+func TestWriteRevokesLease(t *testing.T) {
+	s := newTestServer(t)
+	lease := getLease(t, s, "key")
+	setString(t, s, "key", "fresh")
+	if err := setLeased(s, "key", "stale", lease); !errors.Is(err, ErrLeaseInvalid) {
+		t.Errorf("set with a lease revoked by a write returned %v", err)
+	}
+	if value, _ := getString(t, s, "key"); value != "fresh" {
+		t.Errorf("key reads %q", value)
+	}
+}
+
+func TestDeleteOfMissingKeyRevokesLease(t *testing.T) {
+	s := newTestServer(t)
+	lease := getLease(t, s, "key")
+	if _, err := s.Delete(context.Background(), &pb.DeleteRequest{Key: "key"}); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("delete of a missing key returned %v", err)
+	}
+	if err := setLeased(s, "key", "stale", lease); !errors.Is(err, ErrLeaseInvalid) {
+		t.Errorf("set with a lease revoked by a delete returned %v", err)
+	}
+	if _, err := getString(t, s, "key"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("stale set filled the deleted key: %v", err)
+	}
+}
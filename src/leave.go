@@ -0,0 +1,34 @@
+package src
+
+import (
+	"log"
+	"sync"
+)
+
+/*
+Leaves the cluster before shutdown. Every member is told to drop this node, then the local keys are handed over
+to their new owners with their remaining expiration. Returns once the owners acknowledged their keys, the
+grpc.Server serving s can then be stopped with GracefulStop. Requests received meanwhile are forwarded to the new owners.
+*/
+func (s *Server) Leave() {
+	s.membership.leave()
+	peers := s.peers()
+	if len(peers) == 0 {
+		return
+	}
+	var wg sync.WaitGroup
+	for _, address := range peers {
+		wg.Add(1)
+		go func(address string) {
+			defer wg.Done()
+			if _, err := s.client.DropServer(address, s.selfAddress); err != nil {
+				log.Printf("Could not announce leave to %v: %v", address, err)
+			}
+		}(address)
+	}
+	wg.Wait()
+	s.removeMember(s.selfAddress)
+	if failed, err := s.handOff(); failed > 0 {
+		log.Printf("%v keys could not be handed over: %v", failed, err)
+	}
+}
@@ -0,0 +1,333 @@
+package src
+
+import (
+	"context"
+	pb "drcache/grpc/definitions"
+	"encoding/binary"
+	"errors"
+	"google.golang.org/grpc/status"
+	"log"
+	"sync"
+	"time"
+)
+
+/* With consistent hashing check if key belongs to you, if so push to the list in local cache. Otherwise send to other server with client
+Pushes the values to the head of the list, creating the list if it does not exist.
+*/
+func (s *Server) LPush(ctx context.Context, in *pb.ListPushRequest) (*pb.ListReply, error) {
+	log.Printf("Received: %v", in.Key)
+	nodeAddress := s.ring().Get(in.Key)
+	if nodeAddress == s.selfAddress {
+		return s.pushLocal(in, true)
+	} else {
+		reply, err := s.client.LPushItem(nodeAddress, in)
+		if status.Code(err) == 14 { // Connection Error server is down
+			s.suspectServer(nodeAddress)
+		}
+		return reply, err
+	}
+}
+
+/* With consistent hashing check if key belongs to you, if so push to the list in local cache. Otherwise send to other server with client
+Pushes the values to the tail of the list, creating the list if it does not exist.
+*/
+func (s *Server) RPush(ctx context.Context, in *pb.ListPushRequest) (*pb.ListReply, error) {
+	log.Printf("Received: %v", in.Key)
+	nodeAddress := s.ring().Get(in.Key)
+	if nodeAddress == s.selfAddress {
+		return s.pushLocal(in, false)
+	} else {
+		reply, err := s.client.RPushItem(nodeAddress, in)
+		if status.Code(err) == 14 { // Connection Error server is down
+			s.suspectServer(nodeAddress)
+		}
+		return reply, err
+	}
+}
+
+/* With consistent hashing check if key belongs to you, if so pop from the list in local cache. Otherwise send to other server with client
+Pops values from the head of the list, the list is deleted with its last value.
+*/
+func (s *Server) LPop(ctx context.Context, in *pb.ListPopRequest) (*pb.ListReply, error) {
+	log.Printf("Received: %v", in.Key)
+	nodeAddress := s.ring().Get(in.Key)
+	if nodeAddress == s.selfAddress {
+		return s.popLocal(in, true)
+	} else {
+		reply, err := s.client.LPopItem(nodeAddress, in)
+		if status.Code(err) == 14 { // Connection Error server is down
+			s.suspectServer(nodeAddress)
+		}
+		return reply, err
+	}
+}
+
+/* With consistent hashing check if key belongs to you, if so pop from the list in local cache. Otherwise send to other server with client
+Pops values from the tail of the list, the list is deleted with its last value.
+*/
+func (s *Server) RPop(ctx context.Context, in *pb.ListPopRequest) (*pb.ListReply, error) {
+	log.Printf("Received: %v", in.Key)
+	nodeAddress := s.ring().Get(in.Key)
+	if nodeAddress == s.selfAddress {
+		return s.popLocal(in, false)
+	} else {
+		reply, err := s.client.RPopItem(nodeAddress, in)
+		if status.Code(err) == 14 { // Connection Error server is down
+			s.suspectServer(nodeAddress)
+		}
+		return reply, err
+	}
+}
+
+/* With consistent hashing check if key belongs to you, if so pop from the list in local cache. Otherwise send to other server with client
+Same as LPop, but waits up to the timeout for a value to be pushed when the list is empty or missing.
+The reply is empty if none arrived in time. The wait fails with ErrRingChanged if the list moves to another node meanwhile.
+*/
+func (s *Server) BLPop(ctx context.Context, in *pb.ListPopRequest) (*pb.ListReply, error) {
+	log.Printf("Received: %v", in.Key)
+	nodeAddress := s.ring().Get(in.Key)
+	if nodeAddress == s.selfAddress {
+		return s.blockingPopLocal(ctx, in, true)
+	} else {
+		reply, err := s.client.BLPopItem(ctx, nodeAddress, in)
+		if status.Code(err) == 14 && !errors.Is(err, ErrRingChanged) { // Connection Error server is down
+			s.suspectServer(nodeAddress)
+		}
+		return reply, err
+	}
+}
+
+/* With consistent hashing check if key belongs to you, if so pop from the list in local cache. Otherwise send to other server with client
+Same as RPop, but waits up to the timeout for a value to be pushed when the list is empty or missing.
+The reply is empty if none arrived in time. The wait fails with ErrRingChanged if the list moves to another node meanwhile.
+*/
+func (s *Server) BRPop(ctx context.Context, in *pb.ListPopRequest) (*pb.ListReply, error) {
+	log.Printf("Received: %v", in.Key)
+	nodeAddress := s.ring().Get(in.Key)
+	if nodeAddress == s.selfAddress {
+		return s.blockingPopLocal(ctx, in, false)
+	} else {
+		reply, err := s.client.BRPopItem(ctx, nodeAddress, in)
+		if status.Code(err) == 14 && !errors.Is(err, ErrRingChanged) { // Connection Error server is down
+			s.suspectServer(nodeAddress)
+		}
+		return reply, err
+	}
+}
+
+/* With consistent hashing check if key belongs to you, if so read the list from local cache. Otherwise send to other server with client
+Returns the values from start to stop, both inclusive. A missing list has no values.
+*/
+func (s *Server) LRange(ctx context.Context, in *pb.ListRangeRequest) (*pb.ListReply, error) {
+	nodeAddress := s.ring().Get(in.Key)
+	if nodeAddress == s.selfAddress {
+		item, err := s.readKind(in.Namespace, in.Key, pb.Kind_LIST)
+		if err == ErrNotFound {
+			return &pb.ListReply{}, nil
+		} else if err != nil {
+			return nil, err
+		}
+		values, err := decodeList(item.Value)
+		if err != nil {
+			return nil, err
+		}
+		start, stop := rankRange(in.Start, in.Stop, len(values))
+		return &pb.ListReply{Values: values[start:stop], Length: uint64(len(values)), LastUpdate: item.LastUpdate}, nil
+	} else {
+		reply, err := s.client.LRangeItems(nodeAddress, in)
+		if status.Code(err) == 14 { // Connection Error server is down
+			s.suspectServer(nodeAddress)
+		}
+		return reply, err
+	}
+}
+
+func (s *Server) pushLocal(in *pb.ListPushRequest, head bool) (*pb.ListReply, error) {
+	var length int
+	item, err := s.updateKind(in.Namespace, in.Key, pb.Kind_LIST, in.Expiration, func(raw []byte) ([]byte, error) {
+		values, err := decodeList(raw)
+		if err != nil {
+			return nil, err
+		}
+		if head {
+			pushed := make([][]byte, 0, len(in.Values)+len(values))
+			for i := len(in.Values) - 1; i >= 0; i-- {
+				pushed = append(pushed, in.Values[i])
+			}
+			values = append(pushed, values...)
+		} else {
+			values = append(values, in.Values...)
+		}
+		length = len(values)
+		return encodeList(values), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListReply{Length: uint64(length), LastUpdate: item.LastUpdate}, nil
+}
+
+func (s *Server) popLocal(in *pb.ListPopRequest, head bool) (*pb.ListReply, error) {
+	var popped [][]byte
+	var length int
+	item, err := s.updateKind(in.Namespace, in.Key, pb.Kind_LIST, 0, func(raw []byte) ([]byte, error) {
+		values, err := decodeList(raw)
+		if err != nil {
+			return nil, err
+		}
+		count := int(in.Count)
+		if count == 0 {
+			count = 1
+		}
+		if count > len(values) {
+			count = len(values)
+		}
+		if head {
+			popped, values = values[:count], values[count:]
+		} else {
+			popped, values = values[len(values)-count:], values[:len(values)-count]
+		}
+		length = len(values)
+		return encodeList(values), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListReply{Values: popped, Length: uint64(length), LastUpdate: item.LastUpdate}, nil
+}
+
+/*
+Pops as popLocal does, waiting for a push to the list whenever it comes up empty.
+The waiter is registered before every attempt so that a push between the attempt and the wait is not missed.
+Pushes go to the key's owner, so the wait ends with ErrRingChanged once the key moves to another node.
+*/
+func (s *Server) blockingPopLocal(ctx context.Context, in *pb.ListPopRequest, head bool) (*pb.ListReply, error) {
+	ctx, cancel, moved := s.cancelOnMove(ctx, func() bool { return s.ring().Get(in.Key) != s.selfAddress })
+	defer cancel()
+	var timeout <-chan time.Time
+	if in.Timeout > 0 {
+		timer := time.NewTimer(time.Duration(in.Timeout) * time.Millisecond)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+	id := namespacedKey(in.Namespace, in.Key)
+	for {
+		pushed := s.lists.wait(id)
+		reply, err := s.popLocal(in, head)
+		if err != nil || len(reply.Values) > 0 {
+			s.lists.done(id)
+			return reply, err
+		}
+		select {
+		case <-pushed:
+		case <-timeout:
+			s.lists.done(id)
+			return reply, nil
+		case <-ctx.Done():
+			s.lists.done(id)
+			if moved() {
+				return nil, ErrRingChanged
+			}
+			return nil, ctx.Err()
+		}
+		s.lists.done(id)
+	}
+}
+
+/*
+Resolves an inclusive range of indexes, which count from the end when negative, to the bounds of a slice of the given length.
+*/
+func rankRange(start int64, stop int64, length int) (int, int) {
+	if start < 0 {
+		start += int64(length)
+	}
+	if stop < 0 {
+		stop += int64(length)
+	}
+	if start < 0 {
+		start = 0
+	}
+	if stop >= int64(length) {
+		stop = int64(length) - 1
+	}
+	if start > stop {
+		return 0, 0
+	}
+	return int(start), int(stop) + 1
+}
+
+/*
+Layout: for every value, from head to tail, its length (uvarint) and bytes.
+An empty list encodes to nothing, so that updateKind deletes it.
+*/
+func encodeList(values [][]byte) []byte {
+	size := 0
+	for _, value := range values {
+		size += binary.MaxVarintLen64 + len(value)
+	}
+	buf := make([]byte, size)
+	n := 0
+	for _, value := range values {
+		n += binary.PutUvarint(buf[n:], uint64(len(value)))
+		n += copy(buf[n:], value)
+	}
+	return buf[:n]
+}
+
+func decodeList(raw []byte) ([][]byte, error) {
+	var values [][]byte
+	for len(raw) > 0 {
+		value, rest, err := readChunk(raw)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+		raw = rest
+	}
+	return values, nil
+}
+
+/*
+Blocked pops waiting on lists of this node, keyed by namespacedKey. A write to a list closes the channel
+of its waiters, which then race to pop.
+*/
+type listWaiters struct {
+	pushed  map[string]chan struct{}
+	waiting map[string]int
+	sync.Mutex
+}
+
+func newListWaiters() *listWaiters {
+	return &listWaiters{pushed: make(map[string]chan struct{}), waiting: make(map[string]int)}
+}
+
+func (l *listWaiters) wait(id string) <-chan struct{} {
+	l.Lock()
+	defer l.Unlock()
+	pushed, ok := l.pushed[id]
+	if !ok {
+		pushed = make(chan struct{})
+		l.pushed[id] = pushed
+	}
+	l.waiting[id]++
+	return pushed
+}
+
+func (l *listWaiters) done(id string) {
+	l.Lock()
+	defer l.Unlock()
+	l.waiting[id]--
+	if l.waiting[id] <= 0 {
+		delete(l.waiting, id)
+		delete(l.pushed, id)
+	}
+}
+
+func (l *listWaiters) notify(id string) {
+	l.Lock()
+	defer l.Unlock()
+	if pushed, ok := l.pushed[id]; ok {
+		close(pushed)
+		delete(l.pushed, id)
+	}
+}
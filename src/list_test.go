@@ -0,0 +1,161 @@
+package src
+
+import (
+	"context"
+	pb "drcache/grpc/definitions"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func push(t *testing.T, s *Server, key string, values ...string) *pb.ListReply {
+	t.Helper()
+	in := &pb.ListPushRequest{Key: key}
+	for _, value := range values {
+		in.Values = append(in.Values, []byte(value))
+	}
+	reply, err := s.RPush(context.Background(), in)
+	if err != nil {
+		t.Fatalf("push to %v: %v", key, err)
+	}
+	return reply
+}
+
+func listValues(reply *pb.ListReply) string {
+	values := make([]string, len(reply.Values))
+	for i, value := range reply.Values {
+		values[i] = string(value)
+	}
+	return fmt.Sprint(values)
+}
+
+/*
+Waits until a blocking pop of the key is registered.
+*/
+func waitForPop(t *testing.T, s *Server, key string) {
+	t.Helper()
+	id := namespacedKey("", key)
+	for deadline := time.Now().Add(time.Second); time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+		s.lists.Lock()
+		waiting := s.lists.waiting[id]
+		s.lists.Unlock()
+		if waiting > 0 {
+			return
+		}
+	}
+	t.Fatalf("no pop of %v is waiting", key)
+}
+
+func TestPushPopAndRange(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	push(t, s, "list", "b", "c")
+	reply, err := s.LPush(ctx, &pb.ListPushRequest{Key: "list", Values: [][]byte{[]byte("a"), []byte("z")}})
+	if err != nil || reply.Length != 4 {
+		t.Fatalf("LPush returned %v, %v", reply, err)
+	}
+	if reply, err = s.LRange(ctx, &pb.ListRangeRequest{Key: "list", Start: 0, Stop: -1}); err != nil || listValues(reply) != "[z a b c]" {
+		t.Errorf("LRange returned %v, %v", reply, err)
+	}
+	if reply, err = s.LRange(ctx, &pb.ListRangeRequest{Key: "list", Start: -2, Stop: -1}); err != nil || listValues(reply) != "[b c]" {
+		t.Errorf("LRange of the tail returned %v, %v", reply, err)
+	}
+	if reply, err = s.LPop(ctx, &pb.ListPopRequest{Key: "list"}); err != nil || listValues(reply) != "[z]" || reply.Length != 3 {
+		t.Errorf("LPop returned %v, %v", reply, err)
+	}
+	if reply, err = s.RPop(ctx, &pb.ListPopRequest{Key: "list", Count: 5}); err != nil || listValues(reply) != "[a b c]" {
+		t.Errorf("RPop of more than the length returned %v, %v", reply, err)
+	}
+	if _, err := getString(t, s, "list"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("empty list was kept: %v", err)
+	}
+	if reply, err = s.LPop(ctx, &pb.ListPopRequest{Key: "list"}); err != nil || len(reply.Values) != 0 {
+		t.Errorf("LPop of a missing list returned %v, %v", reply, err)
+	}
+	setString(t, s, "text", "value")
+	if _, err := s.RPush(ctx, &pb.ListPushRequest{Key: "text", Values: [][]byte{nil}}); !errors.Is(err, errWrongKind) {
+		t.Errorf("push to a string returned %v", err)
+	}
+}
+
+func TestBlockingPopWaitsForPush(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	done := make(chan *pb.ListReply)
+	go func() {
+		reply, err := s.BLPop(ctx, &pb.ListPopRequest{Key: "queue", Timeout: 5000})
+		if err != nil {
+			t.Error(err)
+		}
+		done <- reply
+	}()
+	waitForPop(t, s, "queue")
+	push(t, s, "queue", "job")
+	select {
+	case reply := <-done:
+		if listValues(reply) != "[job]" {
+			t.Errorf("BLPop returned %v", reply)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("BLPop did not wake up on a push")
+	}
+
+	start := time.Now()
+	reply, err := s.BRPop(ctx, &pb.ListPopRequest{Key: "queue", Timeout: 50})
+	if err != nil || len(reply.Values) != 0 {
+		t.Errorf("BRPop of an empty list returned %v, %v", reply, err)
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("BRPop returned after %v, before its timeout", elapsed)
+	}
+}
+
+func TestBlockingPopEndsWhenKeyMoves(t *testing.T) {
+	s := newTestServer(t)
+	other := "127.0.0.1:1"
+	s.addMember(other)
+	key := keyOwnedBy(t, s, other, "queue-")
+	s.removeMember(other)
+
+	done := make(chan error)
+	go func() {
+		_, err := s.BLPop(context.Background(), &pb.ListPopRequest{Key: key})
+		done <- err
+	}()
+	waitForPop(t, s, key)
+	s.addMember(other)
+	select {
+	case err := <-done:
+		if !errors.Is(err, ErrRingChanged) {
+			t.Errorf("BLPop of a moved key returned %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("BLPop kept waiting for a key owned by another node")
+	}
+}
+
+func TestRankRange(t *testing.T) {
+	tests := []struct {
+		start, stop int64
+		length      int
+		from, to    int
+	}{
+		{0, -1, 5, 0, 5},
+		{0, 0, 5, 0, 1},
+		{1, 3, 5, 1, 4},
+		{-2, -1, 5, 3, 5},
+		{-10, 2, 5, 0, 3},
+		{2, 10, 5, 2, 5},
+		{3, 1, 5, 0, 0},
+		{5, 10, 5, 0, 0},
+		{0, -1, 0, 0, 0},
+		{-1, -1, 1, 0, 1},
+	}
+	for _, test := range tests {
+		from, to := rankRange(test.start, test.stop, test.length)
+		if from != test.from || to != test.to {
+			t.Errorf("rankRange(%v, %v, %v) = %v, %v, want %v, %v", test.start, test.stop, test.length, from, to, test.from, test.to)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package src
+
+import (
+	"context"
+	pb "drcache/grpc/definitions"
+	"google.golang.org/grpc/codes"
+	"strings"
+	"sync"
+)
+
+var errNoLoader = &Error{codes.NotFound, "NO_LOADER", "Key does not exist and no loader is registered for it."}
+
+/*
+Loader reads a key missing from the cache from the system of record. The loaded value is stored with the
+returned expiration in seconds, 0 means never. Returning ErrNotFound keeps the key missing.
+*/
+type Loader interface {
+	Load(ctx context.Context, namespace string, key string) (value []byte, expiration uint32, err error)
+}
+
+type loaders struct {
+	byPrefix map[string]map[string]Loader // namespace -> key prefix -> loader
+	sync.RWMutex
+}
+
+func newLoaders() *loaders {
+	return &loaders{byPrefix: make(map[string]map[string]Loader)}
+}
+
+/*
+RegisterLoader makes loader fill misses of keys starting with prefix in the namespace, an empty prefix
+covers the whole namespace. When several prefixes match a key, the longest one wins.
+Loaders only run on the key's owner, so every node should register the same loaders.
+*/
+func (s *Server) RegisterLoader(namespace string, prefix string, loader Loader) {
+	s.loaders.Lock()
+	defer s.loaders.Unlock()
+	if _, ok := s.loaders.byPrefix[namespace]; !ok {
+		s.loaders.byPrefix[namespace] = make(map[string]Loader)
+	}
+	s.loaders.byPrefix[namespace][prefix] = loader
+}
+
+func (l *loaders) find(ns string, key string) Loader {
+	l.RLock()
+	defer l.RUnlock()
+	var found Loader
+	longest := -1
+	for prefix, loader := range l.byPrefix[ns] {
+		if strings.HasPrefix(key, prefix) && len(prefix) > longest {
+			found, longest = loader, len(prefix)
+		}
+	}
+	return found
+}
+
+/*
+Loads a missing key and stores it. Concurrent misses of the same key wait for the first one's load
+instead of calling the loader again.
+The load is guarded like a lease: if the key is written or deleted while the loader runs, the loaded value may be
+older than that write and is not stored. The callers then get the key as written, or the loaded value if it was deleted.
+*/
+func (s *Server) loadLocal(ns string, key string) (*pb.Item, error) {
+	loader := s.loaders.find(ns, key)
+	if loader == nil {
+		return nil, errNoLoader
+	}
+	id := namespacedKey(ns, key)
+	lock := s.keyLock(key)
+	return s.loading.do(id, func() (*pb.Item, error) {
+		lock.Lock()
+		// the key may have been loaded or set since the miss
+		if item, err := s.getLocal(ns, key); err == nil {
+			lock.Unlock()
+			return item, nil
+		}
+		s.leases.startLoad(id)
+		lock.Unlock()
+		// not bound to the first caller's context, its cancellation would fail every waiting caller
+		value, expiration, err := loader.Load(context.Background(), ns, key)
+		lock.Lock()
+		defer lock.Unlock()
+		if !s.leases.endLoad(id) {
+			// written or deleted during the load
+			if item, getErr := s.getLocal(ns, key); getErr != ErrNotFound {
+				return item, getErr
+			}
+			if err != nil {
+				return nil, err
+			}
+			return &pb.Item{Key: key, Value: value, Kind: pb.Kind_STRING}, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if _, err := s.setLocal(ns, key, pb.Kind_STRING, value, expiration, nil); err != nil {
+			return nil, err
+		}
+		return s.getLocal(ns, key)
+	})
+}
+
+type flight struct {
+	done chan struct{}
+	item *pb.Item
+	err  error
+}
+
+/*
+Deduplicates concurrent calls by key, every caller gets the result of the call in flight.
+*/
+type flightGroup struct {
+	flights map[string]*flight
+	sync.Mutex
+}
+
+func newFlightGroup() *flightGroup {
+	return &flightGroup{flights: make(map[string]*flight)}
+}
+
+func (g *flightGroup) do(key string, fn func() (*pb.Item, error)) (*pb.Item, error) {
+	g.Lock()
+	if f, ok := g.flights[key]; ok {
+		g.Unlock()
+		<-f.done
+		return f.item, f.err
+	}
+	f := &flight{done: make(chan struct{})}
+	g.flights[key] = f
+	g.Unlock()
+
+	f.item, f.err = fn()
+	g.Lock()
+	delete(g.flights, key)
+	g.Unlock()
+	close(f.done)
+	return f.item, f.err
+}
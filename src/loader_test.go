@@ -0,0 +1,138 @@
+package src
+
+import (
+	"context"
+	pb "drcache/grpc/definitions"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+/*
+Loads "loaded", after release is closed when it is set. Every call is counted and announced on started.
+*/
+type testLoader struct {
+	calls   int32
+	started chan struct{}
+	release chan struct{}
+	err     error
+}
+
+func (l *testLoader) Load(ctx context.Context, namespace string, key string) ([]byte, uint32, error) {
+	atomic.AddInt32(&l.calls, 1)
+	if l.started != nil {
+		l.started <- struct{}{}
+	}
+	if l.release != nil {
+		<-l.release
+	}
+	return []byte("loaded"), 0, l.err
+}
+
+func loadString(s *Server, key string) (string, error) {
+	reply, err := s.Get(context.Background(), &pb.GetRequest{Key: key, Load: true})
+	if err != nil {
+		return "", err
+	}
+	return string(reply.Item.Value), nil
+}
+
+func TestLoadFillsMiss(t *testing.T) {
+	s := newTestServer(t)
+	if _, err := loadString(s, "key"); !errors.Is(err, errNoLoader) {
+		t.Fatalf("load without a loader returned %v", err)
+	}
+	s.RegisterLoader("", "", &testLoader{err: ErrNotFound})
+	loader := &testLoader{}
+	s.RegisterLoader("", "user:", loader)
+
+	if _, err := loadString(s, "other"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("loader returning ErrNotFound gave %v", err)
+	}
+	if _, err := getString(t, s, "other"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("key missing from the system of record was stored: %v", err)
+	}
+	if value, err := loadString(s, "user:1"); err != nil || value != "loaded" {
+		t.Fatalf("load returned %q, %v", value, err)
+	}
+	if value, err := getString(t, s, "user:1"); err != nil || value != "loaded" {
+		t.Errorf("loaded key reads %q, %v", value, err)
+	}
+	if _, err := loadString(s, "user:1"); err != nil || atomic.LoadInt32(&loader.calls) != 1 {
+		t.Errorf("a hit called the loader, %v calls: %v", loader.calls, err)
+	}
+}
+
+func TestConcurrentMissesLoadOnce(t *testing.T) {
+	s := newTestServer(t)
+	loader := &testLoader{started: make(chan struct{}, 10), release: make(chan struct{})}
+	s.RegisterLoader("", "", loader)
+
+	var wg sync.WaitGroup
+	values := make([]string, 5)
+	for i := range values {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			values[i], _ = loadString(s, "key")
+		}(i)
+	}
+	<-loader.started
+	time.Sleep(50 * time.Millisecond) // let the other misses join the load
+	close(loader.release)
+	wg.Wait()
+	if calls := atomic.LoadInt32(&loader.calls); calls != 1 {
+		t.Errorf("loader called %v times", calls)
+	}
+	for i, value := range values {
+		if value != "loaded" {
+			t.Errorf("caller %v got %q", i, value)
+		}
+	}
+}
+
+func TestSetDuringLoadWins(t *testing.T) {
+	s := newTestServer(t)
+	loader := &testLoader{started: make(chan struct{}, 1), release: make(chan struct{})}
+	s.RegisterLoader("", "", loader)
+
+	done := make(chan string)
+	go func() {
+		value, _ := loadString(s, "key")
+		done <- value
+	}()
+	<-loader.started
+	setString(t, s, "key", "fresh")
+	close(loader.release)
+	if value := <-done; value != "fresh" {
+		t.Errorf("the loading caller got %q", value)
+	}
+	if value, err := getString(t, s, "key"); err != nil || value != "fresh" {
+		t.Errorf("stale load overwrote the set, key reads %q, %v", value, err)
+	}
+}
+
+func TestDeleteDuringLoadKeepsKeyMissing(t *testing.T) {
+	s := newTestServer(t)
+	loader := &testLoader{started: make(chan struct{}, 1), release: make(chan struct{})}
+	s.RegisterLoader("", "", loader)
+
+	done := make(chan string)
+	go func() {
+		value, _ := loadString(s, "key")
+		done <- value
+	}()
+	<-loader.started
+	if _, err := s.Delete(context.Background(), &pb.DeleteRequest{Key: "key"}); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("delete during the load returned %v", err)
+	}
+	close(loader.release)
+	if value := <-done; value != "loaded" {
+		t.Errorf("the loading caller got %q", value)
+	}
+	if _, err := getString(t, s, "key"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("load older than the delete was stored: %v", err)
+	}
+}
@@ -0,0 +1,187 @@
+package src
+
+import (
+	"context"
+	pb "drcache/grpc/definitions"
+	"encoding/binary"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log"
+	"sync/atomic"
+	"time"
+)
+
+var errLockTTL = &Error{codes.InvalidArgument, "LOCK_TTL", "Lock TTL must be positive."}
+
+const lockRetention = 3600 // seconds a lock is kept after it is released or expires
+
+/*
+The value of a lock entry. The entry outlives the lock by lockRetention, so that its fencing token survives a release,
+an expiry and a handoff to another owner, and the next holder always gets a greater token.
+Layout: fencing token (8 bytes) | expiry in unix milliseconds (8 bytes), 0 once released | holder
+*/
+type lockValue struct {
+	token   uint64
+	expires int64
+	holder  string
+}
+
+func (l *lockValue) encode() []byte {
+	buf := make([]byte, 16+len(l.holder))
+	binary.BigEndian.PutUint64(buf, l.token)
+	binary.BigEndian.PutUint64(buf[8:], uint64(l.expires))
+	copy(buf[16:], l.holder)
+	return buf
+}
+
+func decodeLock(raw []byte) (*lockValue, error) {
+	if len(raw) < 16 {
+		return nil, errCorruptEntry
+	}
+	return &lockValue{token: binary.BigEndian.Uint64(raw), expires: int64(binary.BigEndian.Uint64(raw[8:])), holder: string(raw[16:])}, nil
+}
+
+func (l *lockValue) held() bool {
+	return l.expires > nowMillis()
+}
+
+func nowMillis() int64 {
+	return time.Now().UnixNano() / int64(time.Millisecond)
+}
+
+/*
+Reads the lock of the key, nil if there is none. Callers must hold the key lock.
+*/
+func (s *Server) peekLock(ns string, key string) (*lockValue, error) {
+	current, err := s.peekLocal(ns, key)
+	if err == ErrNotFound {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if current.kind != pb.Kind_LOCK {
+		return nil, errWrongKind
+	}
+	return decodeLock(current.value)
+}
+
+/*
+Stores the lock, its entry expires lockRetention after the lock. Callers must hold the key lock.
+*/
+func (s *Server) storeLock(ns string, key string, l *lockValue) error {
+	ttl := int64(lockRetention)
+	if remaining := l.expires - nowMillis(); remaining > 0 {
+		ttl += (remaining + 999) / 1000
+	}
+	_, err := s.setLocal(ns, key, pb.Kind_LOCK, l.encode(), clampExpiration(ttl), nil)
+	return err
+}
+
+/* With consistent hashing check if key belongs to you, if so take the lock in local cache. Otherwise send to other server with client
+The lock is an entry of kind LOCK holding its fencing token. Tokens are drawn from the CAS versions of the owner,
+above the token of the previous holder, so they always increase, also across owners.
+Returns ErrLocked while another holder has the lock.
+*/
+func (s *Server) Lock(ctx context.Context, in *pb.LockRequest) (*pb.LockReply, error) {
+	log.Printf("Received lock: %v", in.Key)
+	nodeAddress := s.ring().Get(in.Key)
+	if nodeAddress == s.selfAddress {
+		if in.Ttl == 0 {
+			return nil, errLockTTL
+		}
+		lock := s.keyLock(in.Key)
+		lock.Lock()
+		defer lock.Unlock()
+		current, err := s.peekLock(in.Namespace, in.Key)
+		if err != nil {
+			return nil, err
+		}
+		if current != nil {
+			if current.held() {
+				return nil, ErrLocked
+			}
+			s.observeVersion(current.token)
+		}
+		taken := &lockValue{token: atomic.AddUint64(&s.version, 1), expires: nowMillis() + int64(in.Ttl)*1000, holder: in.Holder}
+		if err := s.storeLock(in.Namespace, in.Key, taken); err != nil {
+			return nil, err
+		}
+		return &pb.LockReply{Token: taken.token, Holder: in.Holder}, nil
+	} else {
+		reply, err := s.client.LockItem(nodeAddress, in)
+		if status.Code(err) == 14 { // Connection Error server is down
+			s.suspectServer(nodeAddress)
+		}
+		return reply, err
+	}
+}
+
+/* With consistent hashing check if key belongs to you, if so release the lock in local cache. Otherwise send to other server with client
+Only the holder of the token may release the lock, returns ErrLockNotHeld otherwise. The lock's token is kept.
+*/
+func (s *Server) Unlock(ctx context.Context, in *pb.LockRequest) (*pb.LockReply, error) {
+	log.Printf("Received unlock: %v", in.Key)
+	nodeAddress := s.ring().Get(in.Key)
+	if nodeAddress == s.selfAddress {
+		lock := s.keyLock(in.Key)
+		lock.Lock()
+		defer lock.Unlock()
+		current, err := s.heldLock(in.Namespace, in.Key, in.Token)
+		if err != nil {
+			return nil, err
+		}
+		current.expires = 0
+		if err := s.storeLock(in.Namespace, in.Key, current); err != nil {
+			return nil, err
+		}
+		return &pb.LockReply{Token: in.Token}, nil
+	} else {
+		reply, err := s.client.UnlockItem(nodeAddress, in)
+		if status.Code(err) == 14 { // Connection Error server is down
+			s.suspectServer(nodeAddress)
+		}
+		return reply, err
+	}
+}
+
+/* With consistent hashing check if key belongs to you, if so extend the lock in local cache. Otherwise send to other server with client
+Restarts the TTL of the lock, keeping its token. Only the holder of the token may refresh the lock, returns ErrLockNotHeld otherwise.
+*/
+func (s *Server) RefreshLock(ctx context.Context, in *pb.LockRequest) (*pb.LockReply, error) {
+	log.Printf("Received refresh lock: %v", in.Key)
+	nodeAddress := s.ring().Get(in.Key)
+	if nodeAddress == s.selfAddress {
+		if in.Ttl == 0 {
+			return nil, errLockTTL
+		}
+		lock := s.keyLock(in.Key)
+		lock.Lock()
+		defer lock.Unlock()
+		current, err := s.heldLock(in.Namespace, in.Key, in.Token)
+		if err != nil {
+			return nil, err
+		}
+		current.expires = nowMillis() + int64(in.Ttl)*1000
+		if err := s.storeLock(in.Namespace, in.Key, current); err != nil {
+			return nil, err
+		}
+		return &pb.LockReply{Token: current.token, Holder: current.holder}, nil
+	} else {
+		reply, err := s.client.RefreshLockItem(nodeAddress, in)
+		if status.Code(err) == 14 { // Connection Error server is down
+			s.suspectServer(nodeAddress)
+		}
+		return reply, err
+	}
+}
+
+/*
+Returns the lock of the key if it is held with token, ErrLockNotHeld otherwise. Callers must hold the key lock.
+*/
+func (s *Server) heldLock(ns string, key string, token uint64) (*lockValue, error) {
+	current, err := s.peekLock(ns, key)
+	if err == errWrongKind || (err == nil && (current == nil || !current.held() || current.token != token)) {
+		return nil, ErrLockNotHeld
+	}
+	return current, err
+}
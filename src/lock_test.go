@@ -0,0 +1,89 @@
+package src
+
+import (
+	"context"
+	pb "drcache/grpc/definitions"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestLockTokensIncrease(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	first, err := s.Lock(ctx, &pb.LockRequest{Key: "job", Ttl: 60, Holder: "a"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Lock(ctx, &pb.LockRequest{Key: "job", Ttl: 60, Holder: "b"}); !errors.Is(err, ErrLocked) {
+		t.Errorf("second Lock returned %v", err)
+	}
+	if _, err := s.Unlock(ctx, &pb.LockRequest{Key: "job", Token: first.Token + 1}); !errors.Is(err, ErrLockNotHeld) {
+		t.Errorf("Unlock with another token returned %v", err)
+	}
+	if _, err := s.Unlock(ctx, &pb.LockRequest{Key: "job", Token: first.Token}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Unlock(ctx, &pb.LockRequest{Key: "job", Token: first.Token}); !errors.Is(err, ErrLockNotHeld) {
+		t.Errorf("second Unlock returned %v", err)
+	}
+	second, err := s.Lock(ctx, &pb.LockRequest{Key: "job", Ttl: 1, Holder: "b"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if second.Token <= first.Token {
+		t.Errorf("token %v after release is not above %v", second.Token, first.Token)
+	}
+	// an expired lock can be taken, with a greater token
+	time.Sleep(1100 * time.Millisecond)
+	if _, err := s.RefreshLock(ctx, &pb.LockRequest{Key: "job", Ttl: 60, Token: second.Token}); !errors.Is(err, ErrLockNotHeld) {
+		t.Errorf("RefreshLock of an expired lock returned %v", err)
+	}
+	third, err := s.Lock(ctx, &pb.LockRequest{Key: "job", Ttl: 60, Holder: "c"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if third.Token <= second.Token {
+		t.Errorf("token %v after expiry is not above %v", third.Token, second.Token)
+	}
+	refreshed, err := s.RefreshLock(ctx, &pb.LockRequest{Key: "job", Ttl: 60, Token: third.Token})
+	if err != nil || refreshed.Token != third.Token || refreshed.Holder != "c" {
+		t.Errorf("RefreshLock returned %v, %v", refreshed, err)
+	}
+}
+
+func TestLockIsNotWritable(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	if _, err := s.Lock(ctx, &pb.LockRequest{Key: "job", Ttl: 60}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Set(ctx, &pb.SetRequest{Item: &pb.Item{Key: "job", Value: []byte("v")}}); !errors.Is(err, errWrongKind) {
+		t.Errorf("Set over a lock returned %v", err)
+	}
+	if _, err := s.Delete(ctx, &pb.DeleteRequest{Key: "job"}); !errors.Is(err, errWrongKind) {
+		t.Errorf("Delete of a lock returned %v", err)
+	}
+	for _, touch := range []func(context.Context, *pb.TouchRequest) (*pb.Reply, error){s.Touch, s.GetAndTouch} {
+		if _, err := touch(ctx, &pb.TouchRequest{Key: "job", Expiration: 1}); !errors.Is(err, errWrongKind) {
+			t.Errorf("touching a lock returned %v", err)
+		}
+	}
+	if _, err := s.Set(ctx, &pb.SetRequest{Item: &pb.Item{Key: "forged", Value: []byte("v"), Kind: pb.Kind_LOCK}}); !errors.Is(err, errWrongKind) {
+		t.Errorf("Set of a lock returned %v", err)
+	}
+	if _, err := s.Lock(ctx, &pb.LockRequest{Key: "job", Ttl: 60}); !errors.Is(err, ErrLocked) {
+		t.Errorf("lock was released by a refused write: %v", err)
+	}
+}
+
+func TestLongLockIsKept(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	if _, err := s.Lock(ctx, &pb.LockRequest{Key: "job", Ttl: 3000000000}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Lock(ctx, &pb.LockRequest{Key: "job", Ttl: 60}); !errors.Is(err, ErrLocked) {
+		t.Errorf("Lock of a key locked for 3e9 seconds returned %v", err)
+	}
+}
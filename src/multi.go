@@ -0,0 +1,127 @@
+package src
+
+import (
+	"context"
+	pb "drcache/grpc/definitions"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sync"
+)
+
+var errMissingResult = &Error{codes.Internal, "MISSING_RESULT", "Owner replied without a result for the key."}
+
+/*
+Sends the indexes of a batch owned by address as one sub-batch, replies are expected in the same order.
+*/
+type batchSender func(address string, indexes []int) (*pb.MultiReply, error)
+
+/* Gets many keys at once. Keys are grouped by their owner and every owner receives one sub-batch in parallel.
+A missing key does not fail the batch, its error is reported in its own result.
+*/
+func (s *Server) MultiGet(ctx context.Context, in *pb.MultiGetRequest) (*pb.MultiReply, error) {
+	results := s.fanOut(in.Keys, func(i int) *pb.KeyResult {
+		item, err := s.getLocal(in.Namespace, in.Keys[i])
+		return keyResult(in.Keys[i], item, err)
+	}, func(address string, indexes []int) (*pb.MultiReply, error) {
+		keys := make([]string, len(indexes))
+		for j, i := range indexes {
+			keys[j] = in.Keys[i]
+		}
+		return s.client.MultiGetItems(address, &pb.MultiGetRequest{Keys: keys, Namespace: in.Namespace})
+	})
+	return &pb.MultiReply{Results: results}, nil
+}
+
+/* Sets many items at once. Keys are grouped by their owner and every owner receives one sub-batch in parallel.
+Every item gets its own version, reported in its result.
+*/
+func (s *Server) MultiSet(ctx context.Context, in *pb.MultiSetRequest) (*pb.MultiReply, error) {
+	keys := make([]string, len(in.Items))
+	for i, item := range in.Items {
+		keys[i] = item.Key
+	}
+	results := s.fanOut(keys, func(i int) *pb.KeyResult {
+		lock := s.keyLock(keys[i])
+		lock.Lock()
+		defer lock.Unlock()
+		if err := s.checkStringWrite(in.Namespace, keys[i], in.Items[i].Kind); err != nil {
+			return keyResult(keys[i], nil, err)
+		}
+		item, err := s.setLocal(in.Namespace, keys[i], pb.Kind_STRING, in.Items[i].Value, in.Items[i].Expiration, in.Items[i].Tags)
+		return keyResult(keys[i], item, err)
+	}, func(address string, indexes []int) (*pb.MultiReply, error) {
+		items := make([]*pb.Item, len(indexes))
+		for j, i := range indexes {
+			items[j] = in.Items[i]
+		}
+		return s.client.MultiSetItems(address, &pb.MultiSetRequest{Items: items, Namespace: in.Namespace})
+	})
+	return &pb.MultiReply{Results: results}, nil
+}
+
+/* Deletes many keys at once. Keys are grouped by their owner and every owner receives one sub-batch in parallel.
+A missing key does not fail the batch, its error is reported in its own result.
+*/
+func (s *Server) MultiDelete(ctx context.Context, in *pb.MultiDeleteRequest) (*pb.MultiReply, error) {
+	results := s.fanOut(in.Keys, func(i int) *pb.KeyResult {
+		if err := s.deleteKey(in.Namespace, in.Keys[i]); err != nil {
+			return keyResult(in.Keys[i], nil, err)
+		}
+		return &pb.KeyResult{Key: in.Keys[i]}
+	}, func(address string, indexes []int) (*pb.MultiReply, error) {
+		keys := make([]string, len(indexes))
+		for j, i := range indexes {
+			keys[j] = in.Keys[i]
+		}
+		return s.client.MultiDeleteItems(address, &pb.MultiDeleteRequest{Keys: keys, Namespace: in.Namespace})
+	})
+	return &pb.MultiReply{Results: results}, nil
+}
+
+/*
+Groups keys by ring owner, sends one sub-batch to every other owner in parallel and runs the local ones in place.
+Results are returned in the order of keys.
+*/
+func (s *Server) fanOut(keys []string, local func(i int) *pb.KeyResult, remote batchSender) []*pb.KeyResult {
+	groups := make(map[string][]int)
+	for i, key := range keys {
+		owner := s.ring().Get(key)
+		groups[owner] = append(groups[owner], i)
+	}
+	results := make([]*pb.KeyResult, len(keys))
+	var wg sync.WaitGroup
+	for address, indexes := range groups {
+		if address == s.selfAddress {
+			continue
+		}
+		wg.Add(1)
+		go func(address string, indexes []int) {
+			defer wg.Done()
+			reply, err := remote(address, indexes)
+			if status.Code(err) == 14 { // Connection Error server is down
+				s.suspectServer(address)
+			}
+			for j, i := range indexes {
+				if err == nil && j < len(reply.Results) {
+					results[i] = reply.Results[j]
+				} else if err != nil {
+					results[i] = keyResult(keys[i], nil, err)
+				} else {
+					results[i] = keyResult(keys[i], nil, errMissingResult)
+				}
+			}
+		}(address, indexes)
+	}
+	for _, i := range groups[s.selfAddress] {
+		results[i] = local(i)
+	}
+	wg.Wait()
+	return results
+}
+
+func keyResult(key string, item *pb.Item, err error) *pb.KeyResult {
+	if err != nil {
+		return &pb.KeyResult{Key: key, Error: err.Error(), Code: uint32(status.Code(err))}
+	}
+	return &pb.KeyResult{Key: key, Item: item}
+}
@@ -0,0 +1,75 @@
+package src
+
+import (
+	"context"
+	pb "drcache/grpc/definitions"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"testing"
+)
+
+func TestMultiAcrossOwners(t *testing.T) {
+	first, second := startCluster(t)
+	ctx := context.Background()
+	const keys = 20
+	var items []*pb.Item
+	var names []string
+	owners := make(map[string]int)
+	for i := 0; i < keys; i++ {
+		key := fmt.Sprint("key-", i)
+		items = append(items, &pb.Item{Key: key, Value: []byte(fmt.Sprint(i))})
+		names = append(names, key)
+		owners[first.ring().Get(key)]++
+	}
+	if len(owners) != 2 {
+		t.Fatalf("all keys are owned by one node: %v", owners)
+	}
+
+	set, err := first.MultiSet(ctx, &pb.MultiSetRequest{Items: items})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, result := range set.Results {
+		if result.Key != names[i] || result.Error != "" || result.Item.LastUpdate == 0 {
+			t.Errorf("set result %v is %v", i, result)
+		}
+	}
+
+	got, err := second.MultiGet(ctx, &pb.MultiGetRequest{Keys: append(names, "missing")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, result := range got.Results[:keys] {
+		if result.Key != names[i] || string(result.Item.GetValue()) != fmt.Sprint(i) || result.Item.LastUpdate != set.Results[i].Item.LastUpdate {
+			t.Errorf("get result %v is %v", i, result)
+		}
+	}
+	if missing := got.Results[keys]; missing.Key != "missing" || codes.Code(missing.Code) != codes.NotFound {
+		t.Errorf("missing key result is %v", missing)
+	}
+
+	deleted, err := first.MultiDelete(ctx, &pb.MultiDeleteRequest{Keys: []string{names[0], "missing", names[1]}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deleted.Results[0].Error != "" || codes.Code(deleted.Results[1].Code) != codes.NotFound || deleted.Results[2].Error != "" {
+		t.Errorf("delete results are %v", deleted.Results)
+	}
+	if _, err := getString(t, second.Server, names[0]); err == nil {
+		t.Errorf("%v was not deleted", names[0])
+	}
+}
+
+func TestMultiSetRefusesOtherKinds(t *testing.T) {
+	s := newTestServer(t)
+	reply, err := s.MultiSet(context.Background(), &pb.MultiSetRequest{Items: []*pb.Item{
+		{Key: "string", Value: []byte("v")},
+		{Key: "lock", Value: []byte("forged"), Kind: pb.Kind_LOCK},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reply.Results[0].Error != "" || reply.Results[1].Error != errWrongKind.Error() {
+		t.Errorf("results are %v", reply.Results)
+	}
+}
@@ -0,0 +1,136 @@
+package src
+
+import (
+	"context"
+	pb "drcache/grpc/definitions"
+	lru "github.com/coocood/freecache"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log"
+	"sync"
+	"time"
+)
+
+var errNamespaceNotFound = &Error{codes.NotFound, "NAMESPACE_NOT_FOUND", "Namespace does not exist."}
+var errNamespaceExists = &Error{codes.AlreadyExists, "NAMESPACE_EXISTS", "Namespace already exists with a different quota."}
+var errInvalidQuota = &Error{codes.InvalidArgument, "INVALID_QUOTA", "Quota must be between 512KB and 1GB."}
+
+const (
+	minNamespaceQuota = 512 * 1024 // freecache enlarges smaller caches to this silently
+	maxNamespaceQuota = 1 << 30    // the whole quota is allocated up front on every node
+)
+
+/*
+Every namespace is a separate freecache partition, so a flush or a burst of writes in one namespace
+can not evict the entries of another. The default namespace is the empty string and is sized by NewServer.
+*/
+type namespace struct {
+	cache    *lru.Cache
+	quota    uint64    // bytes
+	bytes    uint64    // memory usage when last measured, guarded by the mutex
+	measured time.Time // when bytes was measured
+	sync.Mutex
+}
+
+type namespaces struct {
+	spaces map[string]*namespace
+	sync.RWMutex
+}
+
+func newNamespaces(defaultSize int) *namespaces {
+	if defaultSize < minNamespaceQuota {
+		defaultSize = minNamespaceQuota
+	}
+	return &namespaces{spaces: map[string]*namespace{"": {cache: lru.NewCache(defaultSize), quota: uint64(defaultSize)}}}
+}
+
+func (n *namespaces) get(name string) (*namespace, error) {
+	n.RLock()
+	defer n.RUnlock()
+	space, ok := n.spaces[name]
+	if !ok {
+		return nil, errNamespaceNotFound
+	}
+	return space, nil
+}
+
+func (n *namespaces) create(name string, quota uint64) error {
+	if quota < minNamespaceQuota || quota > maxNamespaceQuota {
+		return errInvalidQuota
+	}
+	n.Lock()
+	defer n.Unlock()
+	if space, ok := n.spaces[name]; ok {
+		if space.quota != quota {
+			return errNamespaceExists
+		}
+		return nil
+	}
+	n.spaces[name] = &namespace{cache: lru.NewCache(int(quota)), quota: quota}
+	return nil
+}
+
+func (n *namespaces) all() map[string]*namespace {
+	n.RLock()
+	defer n.RUnlock()
+	spaces := make(map[string]*namespace, len(n.spaces))
+	for name, space := range n.spaces {
+		spaces[name] = space
+	}
+	return spaces
+}
+
+/* Creates the namespace with its own byte quota on every node of the cluster.
+Creating an existing namespace with the same quota succeeds, so a create can be retried safely.
+The quota is allocated on every node when the namespace is created, it must be between 512KB and 1GB.
+*/
+func (s *Server) CreateNamespace(ctx context.Context, in *pb.NamespaceRequest) (*pb.Reply, error) {
+	log.Printf("Received namespace: %v", in.Namespace)
+	if err := s.namespaces.create(in.Namespace, in.Quota); err != nil {
+		return nil, err
+	}
+	if !in.Local {
+		for _, address := range s.peers() {
+			_, err := s.client.CreateNamespace(address, &pb.NamespaceRequest{Namespace: in.Namespace, Quota: in.Quota, Local: true})
+			if status.Code(err) == 14 { // Connection Error server is down
+				s.suspectServer(address)
+			} else if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return &pb.Reply{Message: "ok"}, nil
+}
+
+/* Returns the quota and cache counters of the namespace on the receiving node.
+Figures of the other nodes are not included.
+*/
+func (s *Server) DescribeNamespace(ctx context.Context, in *pb.NamespaceRequest) (*pb.NamespaceStats, error) {
+	space, err := s.namespaces.get(in.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	return namespaceStats(in.Namespace, space), nil
+}
+
+/*
+Largest entry, key and value, freecache stores in the namespace: a quarter of one of its 256 segments, less the entry header.
+*/
+func (space *namespace) maxEntrySize() int {
+	return int(space.quota)/1024 - lru.ENTRY_HDR_SIZE
+}
+
+/*
+Reports whether a value of valueSize bytes, stored without tags under key, fits in the namespace.
+*/
+func (space *namespace) fits(key string, valueSize int) bool {
+	return len(key)+headerSize+1+valueSize <= space.maxEntrySize()
+}
+
+func (s *Server) cache(name string) (*lru.Cache, error) {
+	space, err := s.namespaces.get(name)
+	if err != nil {
+		return nil, err
+	}
+	return space.cache, nil
+}
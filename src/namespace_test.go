@@ -0,0 +1,71 @@
+package src
+
+import (
+	"context"
+	pb "drcache/grpc/definitions"
+	"errors"
+	"testing"
+)
+
+func TestNamespaceQuota(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	for _, quota := range []uint64{0, minNamespaceQuota - 1, maxNamespaceQuota + 1} {
+		if _, err := s.CreateNamespace(ctx, &pb.NamespaceRequest{Namespace: "ns", Quota: quota}); !errors.Is(err, errInvalidQuota) {
+			t.Errorf("quota %v returned %v", quota, err)
+		}
+	}
+	if _, err := s.CreateNamespace(ctx, &pb.NamespaceRequest{Namespace: "ns", Quota: 1 << 20}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.CreateNamespace(ctx, &pb.NamespaceRequest{Namespace: "ns", Quota: 1 << 20}); err != nil {
+		t.Errorf("creating the namespace again returned %v", err)
+	}
+	if _, err := s.CreateNamespace(ctx, &pb.NamespaceRequest{Namespace: "ns", Quota: 2 << 20}); !errors.Is(err, errNamespaceExists) {
+		t.Errorf("another quota returned %v", err)
+	}
+	stats, err := s.DescribeNamespace(ctx, &pb.NamespaceRequest{Namespace: "ns"})
+	if err != nil || stats.Quota != 1<<20 {
+		t.Errorf("DescribeNamespace returned %v, %v", stats, err)
+	}
+	if stats, _ := s.DescribeNamespace(ctx, &pb.NamespaceRequest{}); stats.Quota != minNamespaceQuota {
+		t.Errorf("default namespace quota is %v", stats.Quota)
+	}
+}
+
+func TestNamespacesAreIsolated(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	if _, err := s.CreateNamespace(ctx, &pb.NamespaceRequest{Namespace: "other", Quota: minNamespaceQuota}); err != nil {
+		t.Fatal(err)
+	}
+	setString(t, s, "key", "default")
+	if _, err := s.Set(ctx, &pb.SetRequest{Item: &pb.Item{Key: "key", Value: []byte("other")}, Namespace: "other"}); err != nil {
+		t.Fatal(err)
+	}
+	reply, err := s.Get(ctx, &pb.GetRequest{Key: "key", Namespace: "other"})
+	if err != nil || string(reply.Item.Value) != "other" {
+		t.Errorf("Get in other returned %v, %v", reply, err)
+	}
+	if _, err := s.DeleteAll(ctx, &pb.DeleteAllRequest{Namespace: "other"}); err != nil {
+		t.Fatal(err)
+	}
+	if value, err := getString(t, s, "key"); err != nil || value != "default" {
+		t.Errorf("flushing other changed the default namespace: %q, %v", value, err)
+	}
+	if _, err := s.Get(ctx, &pb.GetRequest{Key: "key", Namespace: "missing"}); !errors.Is(err, errNamespaceNotFound) {
+		t.Errorf("Get in a missing namespace returned %v", err)
+	}
+}
+
+func TestCreateNamespaceOnEveryNode(t *testing.T) {
+	first, second := startCluster(t)
+	if _, err := second.CreateNamespace(context.Background(), &pb.NamespaceRequest{Namespace: "sessions", Quota: minNamespaceQuota}); err != nil {
+		t.Fatal(err)
+	}
+	for _, node := range []*testNode{first, second} {
+		if _, err := node.namespaces.get("sessions"); err != nil {
+			t.Errorf("%v: %v", node.selfAddress, err)
+		}
+	}
+}
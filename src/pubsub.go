@@ -0,0 +1,229 @@
+package src
+
+import (
+	"context"
+	pb "drcache/grpc/definitions"
+	"errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log"
+	"sync"
+)
+
+var errSubscriberTooSlow = &Error{codes.ResourceExhausted, "SUBSCRIBER_TOO_SLOW", "Subscriber fell behind, messages were dropped."}
+
+const subscriberBufferSize = 64
+
+type subscriber struct {
+	channels map[string]struct{}
+	patterns []string
+	messages chan *pb.Message
+	closed   bool
+	sync.Mutex
+}
+
+/*
+Returns the pattern the channel matched, empty for a channel subscription.
+*/
+func (sub *subscriber) matches(channel string) (string, bool) {
+	if _, ok := sub.channels[channel]; ok {
+		return "", true
+	}
+	for _, pattern := range sub.patterns {
+		if globMatch(pattern, channel) {
+			return pattern, true
+		}
+	}
+	return "", false
+}
+
+/*
+Never blocks the publisher. A subscriber that does not keep up is closed and its stream ends with errSubscriberTooSlow.
+Reports whether the message was queued.
+*/
+func (sub *subscriber) send(message *pb.Message) bool {
+	sub.Lock()
+	defer sub.Unlock()
+	if sub.closed {
+		return false
+	}
+	select {
+	case sub.messages <- message:
+		return true
+	default:
+		sub.closed = true
+		close(sub.messages)
+		return false
+	}
+}
+
+/*
+Local subscribers of this node. The node is the broker of the channels the ring assigns to it, messages published
+to other channels reach local subscribers through the subscriptions they relay to the brokers.
+*/
+type broker struct {
+	subscribers map[*subscriber]struct{}
+	sync.Mutex
+}
+
+func newBroker() *broker {
+	return &broker{subscribers: make(map[*subscriber]struct{})}
+}
+
+func (b *broker) subscribe(channels []string, patterns []string) *subscriber {
+	b.Lock()
+	defer b.Unlock()
+	sub := &subscriber{channels: make(map[string]struct{}), patterns: patterns, messages: make(chan *pb.Message, subscriberBufferSize)}
+	for _, channel := range channels {
+		sub.channels[channel] = struct{}{}
+	}
+	b.subscribers[sub] = struct{}{}
+	return sub
+}
+
+func (b *broker) unsubscribe(sub *subscriber) {
+	b.Lock()
+	defer b.Unlock()
+	delete(b.subscribers, sub)
+}
+
+/*
+Returns the number of subscriptions the message was queued for. Every subscription relayed to this broker
+stands for exactly one client subscription on the relaying node, relays are never shared, so this counts the
+client subscriptions of the whole cluster.
+*/
+func (b *broker) publish(channel string, payload []byte) uint32 {
+	b.Lock()
+	defer b.Unlock()
+	var receivers uint32
+	for sub := range b.subscribers {
+		if pattern, ok := sub.matches(channel); ok && sub.send(&pb.Message{Channel: channel, Pattern: pattern, Payload: payload}) {
+			receivers++
+		}
+	}
+	return receivers
+}
+
+/* With consistent hashing check if channel belongs to you, if so deliver to local subscribers. Otherwise send to other server with client
+Subscribers on other nodes receive the message through the subscriptions they relay to this broker.
+*/
+func (s *Server) Publish(ctx context.Context, in *pb.PublishRequest) (*pb.PublishReply, error) {
+	log.Printf("Received publish: %v", in.Channel)
+	nodeAddress := s.ring().Get(in.Channel)
+	if nodeAddress == s.selfAddress {
+		return &pb.PublishReply{Receivers: s.broker.publish(in.Channel, in.Payload)}, nil
+	} else {
+		reply, err := s.client.PublishMessage(nodeAddress, in)
+		if status.Code(err) == 14 { // Connection Error server is down
+			s.suspectServer(nodeAddress)
+		}
+		return reply, err
+	}
+}
+
+/* Streams the messages published to the channels, and to every channel matching the patterns.
+Channels are subscribed on their broker, and a pattern may match channels of any broker, so a pattern
+subscription subscribes locally and relays to all peers.
+Relays are set up from the ring, so the stream ends with ErrRingChanged once a channel moves to another broker,
+or for a pattern subscription once the members change, and the client subscribes again.
+*/
+func (s *Server) Subscribe(in *pb.SubscribeRequest, stream pb.Drcache_SubscribeServer) error {
+	log.Printf("Received subscribe: %v %v", in.Channels, in.Patterns)
+	channels := in.Channels
+	relays := make(map[string]*pb.SubscribeRequest)
+	if !in.Local {
+		channels = nil
+		for _, channel := range in.Channels {
+			nodeAddress := s.ring().Get(channel)
+			if nodeAddress == s.selfAddress {
+				channels = append(channels, channel)
+				continue
+			}
+			if _, ok := relays[nodeAddress]; !ok {
+				relays[nodeAddress] = &pb.SubscribeRequest{Patterns: in.Patterns, Local: true}
+			}
+			relays[nodeAddress].Channels = append(relays[nodeAddress].Channels, channel)
+		}
+		if len(in.Patterns) > 0 {
+			for _, address := range s.peers() {
+				if _, ok := relays[address]; !ok {
+					relays[address] = &pb.SubscribeRequest{Patterns: in.Patterns, Local: true}
+				}
+			}
+		}
+	}
+	sub := s.broker.subscribe(channels, in.Patterns)
+	defer s.broker.unsubscribe(sub)
+
+	var ctx context.Context
+	var cancel context.CancelFunc
+	moved := func() bool { return false }
+	switch {
+	case in.Local:
+		ctx, cancel = context.WithCancel(stream.Context())
+	case len(in.Patterns) > 0:
+		ctx, cancel, moved = s.cancelOnMove(stream.Context(), func() bool { return true })
+	default:
+		owners := make(map[string]string, len(in.Channels))
+		for _, channel := range in.Channels {
+			owners[channel] = s.ring().Get(channel)
+		}
+		ctx, cancel, moved = s.cancelOnMove(stream.Context(), func() bool {
+			for channel, owner := range owners {
+				if s.ring().Get(channel) != owner {
+					return true
+				}
+			}
+			return false
+		})
+	}
+	defer cancel()
+	relayErrors := make(chan error, 1)
+	for address, request := range relays {
+		go func(address string, request *pb.SubscribeRequest) {
+			err := s.relaySubscribe(ctx, address, request, func(message *pb.Message) { sub.send(message) })
+			if ctx.Err() == nil {
+				select {
+				case relayErrors <- err:
+				default:
+				}
+			}
+		}(address, request)
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			if moved() {
+				return ErrRingChanged
+			}
+			return ctx.Err()
+		case err := <-relayErrors:
+			return err
+		case message, ok := <-sub.messages:
+			if !ok {
+				return errSubscriberTooSlow
+			}
+			if err := stream.Send(message); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+/*
+Opens a subscription on address and hands every message to deliver until the stream or ctx ends.
+*/
+func (s *Server) relaySubscribe(ctx context.Context, address string, in *pb.SubscribeRequest, deliver func(*pb.Message)) error {
+	remote, err := s.client.SubscribeChannels(ctx, address, in)
+	for err == nil {
+		var message *pb.Message
+		message, err = remote.Recv()
+		if err == nil {
+			deliver(message)
+		}
+	}
+	if status.Code(err) == 14 && !errors.Is(err, ErrRingChanged) { // Connection Error server is down
+		s.suspectServer(address)
+	}
+	return err
+}
@@ -0,0 +1,122 @@
+package src
+
+import (
+	"context"
+	pb "drcache/grpc/definitions"
+	"testing"
+	"time"
+)
+
+/*
+Opens a subscription on the node and returns its messages, the channel is closed when the stream ends.
+*/
+func subscribe(t *testing.T, node *testNode, in *pb.SubscribeRequest) <-chan *pb.Message {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	stream, err := node.client.SubscribeChannels(ctx, node.selfAddress, in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	messages := make(chan *pb.Message, subscriberBufferSize)
+	go func() {
+		defer close(messages)
+		for {
+			message, err := stream.Recv()
+			if err != nil {
+				return
+			}
+			messages <- message
+		}
+	}()
+	return messages
+}
+
+/*
+Waits until the node's broker has count subscribers, local or relayed.
+*/
+func waitForSubscribers(t *testing.T, node *testNode, count int) {
+	t.Helper()
+	for deadline := time.Now().Add(time.Second); time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+		node.broker.Lock()
+		subscribers := len(node.broker.subscribers)
+		node.broker.Unlock()
+		if subscribers == count {
+			return
+		}
+	}
+	t.Fatalf("%v has no %v subscribers", node.selfAddress, count)
+}
+
+func nextMessage(t *testing.T, messages <-chan *pb.Message) *pb.Message {
+	t.Helper()
+	select {
+	case message, ok := <-messages:
+		if !ok {
+			t.Fatal("subscription ended")
+		}
+		return message
+	case <-time.After(time.Second):
+		t.Fatal("no message")
+	}
+	return nil
+}
+
+func publish(t *testing.T, node *testNode, channel string, payload string) uint32 {
+	t.Helper()
+	reply, err := node.Publish(context.Background(), &pb.PublishRequest{Channel: channel, Payload: []byte(payload)})
+	if err != nil {
+		t.Fatalf("publish to %v: %v", channel, err)
+	}
+	return reply.Receivers
+}
+
+func TestSubscribeToRemoteBroker(t *testing.T) {
+	first, second := startCluster(t)
+	channel := keyOwnedBy(t, first.Server, second.selfAddress, "news-")
+	if receivers := publish(t, first, channel, "unheard"); receivers != 0 {
+		t.Errorf("publish without subscribers reached %v", receivers)
+	}
+	messages := subscribe(t, first, &pb.SubscribeRequest{Channels: []string{channel}})
+	waitForSubscribers(t, second, 1)
+
+	if receivers := publish(t, first, channel, "hello"); receivers != 1 {
+		t.Errorf("publish reached %v subscriptions, want 1", receivers)
+	}
+	message := nextMessage(t, messages)
+	if message.Channel != channel || string(message.Payload) != "hello" || message.Pattern != "" {
+		t.Errorf("received %v", message)
+	}
+}
+
+func TestSubscribeToPattern(t *testing.T) {
+	first, second := startCluster(t)
+	local := keyOwnedBy(t, first.Server, first.selfAddress, "news-")
+	remote := keyOwnedBy(t, first.Server, second.selfAddress, "news-")
+	patterned := subscribe(t, first, &pb.SubscribeRequest{Patterns: []string{"news-*"}})
+	direct := subscribe(t, second, &pb.SubscribeRequest{Channels: []string{remote}})
+	waitForSubscribers(t, first, 1)
+	waitForSubscribers(t, second, 2)
+
+	if receivers := publish(t, second, local, "one"); receivers != 1 {
+		t.Errorf("publish to %v reached %v subscriptions, want 1", local, receivers)
+	}
+	if receivers := publish(t, first, remote, "two"); receivers != 2 {
+		t.Errorf("publish to %v reached %v subscriptions, want 2", remote, receivers)
+	}
+	publish(t, first, "other", "unmatched")
+	for _, want := range []string{local, remote} {
+		message := nextMessage(t, patterned)
+		if message.Channel != want || message.Pattern != "news-*" {
+			t.Errorf("pattern subscription received %v, want a message of %v", message, want)
+		}
+	}
+	if message := nextMessage(t, direct); message.Channel != remote || string(message.Payload) != "two" {
+		t.Errorf("channel subscription received %v", message)
+	}
+	select {
+	case message := <-patterned:
+		t.Errorf("pattern subscription received %v", message)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
@@ -0,0 +1,89 @@
+package src
+
+import (
+	"context"
+	pb "drcache/grpc/definitions"
+	"encoding/binary"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log"
+	"math"
+	"time"
+)
+
+var errInvalidBucket = &Error{codes.InvalidArgument, "INVALID_BUCKET", "Capacity and rate must be positive, and cost at most capacity."}
+
+/* With consistent hashing check if key belongs to you, if so take tokens from the bucket in local cache. Otherwise send to other server with client
+The bucket is refilled at rate tokens per second up to capacity, and the request is allowed if it holds cost tokens.
+The bucket expires once it would be full again, so idle buckets do not stay in cache.
+*/
+func (s *Server) RateLimit(ctx context.Context, in *pb.RateLimitRequest) (*pb.RateLimitReply, error) {
+	log.Printf("Received rate limit: %v", in.Key)
+	nodeAddress := s.ring().Get(in.Key)
+	if nodeAddress == s.selfAddress {
+		cost := in.Cost
+		if cost == 0 {
+			cost = 1
+		}
+		if !(in.Capacity > 0) || !(in.Rate > 0) || !(cost > 0) || cost > in.Capacity {
+			return nil, errInvalidBucket
+		}
+		lock := s.keyLock(in.Key)
+		lock.Lock()
+		defer lock.Unlock()
+
+		now := time.Now().UnixNano()
+		tokens := in.Capacity
+		var tags []string
+		current, err := s.peekLocal(in.Namespace, in.Key)
+		if err == nil {
+			if current.kind != pb.Kind_TOKEN_BUCKET {
+				return nil, errWrongKind
+			}
+			stored, refilledAt, err := decodeBucket(current.value)
+			if err != nil {
+				return nil, err
+			}
+			tokens = math.Min(in.Capacity, stored+float64(now-refilledAt)/float64(time.Second)*in.Rate)
+			tags = current.tags
+		} else if err != ErrNotFound {
+			return nil, err
+		}
+		if tokens < cost {
+			wait := math.Ceil((cost - tokens) / in.Rate * 1000)
+			return &pb.RateLimitReply{Remaining: tokens, RetryAfter: uint32(math.Min(wait, math.MaxUint32))}, nil
+		}
+		tokens -= cost
+		full := clampExpiration(int64(math.Min(math.Ceil((in.Capacity-tokens)/in.Rate), math.MaxUint32)))
+		if full == 0 {
+			full = 1
+		}
+		if _, err := s.setLocal(in.Namespace, in.Key, pb.Kind_TOKEN_BUCKET, encodeBucket(tokens, now), full, tags); err != nil {
+			return nil, err
+		}
+		return &pb.RateLimitReply{Allowed: true, Remaining: tokens}, nil
+	} else {
+		reply, err := s.client.RateLimitItem(nodeAddress, in)
+		if status.Code(err) == 14 { // Connection Error server is down
+			s.suspectServer(nodeAddress)
+		}
+		return reply, err
+	}
+}
+
+/*
+Layout: tokens (8 bytes, IEEE 754) | unix nanoseconds of the last refill (8 bytes)
+*/
+func encodeBucket(tokens float64, refilledAt int64) []byte {
+	buf := make([]byte, 16)
+	binary.BigEndian.PutUint64(buf, math.Float64bits(tokens))
+	binary.BigEndian.PutUint64(buf[8:], uint64(refilledAt))
+	return buf
+}
+
+func decodeBucket(raw []byte) (float64, int64, error) {
+	if len(raw) != 16 {
+		return 0, 0, errCorruptEntry
+	}
+	return math.Float64frombits(binary.BigEndian.Uint64(raw)), int64(binary.BigEndian.Uint64(raw[8:])), nil
+}
@@ -0,0 +1,62 @@
+package src
+
+import (
+	"context"
+	pb "drcache/grpc/definitions"
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestRateLimitBucket(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	in := &pb.RateLimitRequest{Key: "api", Capacity: 2, Rate: 10}
+	for i := 0; i < 2; i++ {
+		if reply, err := s.RateLimit(ctx, in); err != nil || !reply.Allowed {
+			t.Fatalf("request %v of a full bucket returned %v, %v", i, reply, err)
+		}
+	}
+	reply, err := s.RateLimit(ctx, in)
+	if err != nil || reply.Allowed {
+		t.Fatalf("request of an empty bucket returned %v, %v", reply, err)
+	}
+	if reply.RetryAfter == 0 || reply.RetryAfter > 100 {
+		t.Errorf("retry after %vms, want at most the 100ms to refill a token", reply.RetryAfter)
+	}
+	time.Sleep(time.Duration(reply.RetryAfter) * time.Millisecond)
+	if reply, err := s.RateLimit(ctx, in); err != nil || !reply.Allowed {
+		t.Errorf("request after the refill returned %v, %v", reply, err)
+	}
+}
+
+func TestRateLimitErrors(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	for _, in := range []*pb.RateLimitRequest{{Key: "api", Rate: 1}, {Key: "api", Capacity: 1}, {Key: "api", Capacity: 1, Rate: 1, Cost: 2}} {
+		if _, err := s.RateLimit(ctx, in); !errors.Is(err, errInvalidBucket) {
+			t.Errorf("%v returned %v", in, err)
+		}
+	}
+	setString(t, s, "text", "value")
+	if _, err := s.RateLimit(ctx, &pb.RateLimitRequest{Key: "text", Capacity: 1, Rate: 1}); !errors.Is(err, errWrongKind) {
+		t.Errorf("rate limit of a string returned %v", err)
+	}
+}
+
+func TestSlowBucketIsKept(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	in := &pb.RateLimitRequest{Key: "api", Capacity: 1, Rate: 1e-12}
+	if reply, err := s.RateLimit(ctx, in); err != nil || !reply.Allowed {
+		t.Fatalf("first request returned %v, %v", reply, err)
+	}
+	reply, err := s.RateLimit(ctx, in)
+	if err != nil || reply.Allowed {
+		t.Fatalf("bucket refilling once in 30000 years allowed a second request: %v, %v", reply, err)
+	}
+	if reply.RetryAfter != math.MaxUint32 {
+		t.Errorf("retry after %vms, want the longest wait that fits", reply.RetryAfter)
+	}
+}
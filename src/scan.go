@@ -0,0 +1,276 @@
+package src
+
+import (
+	"context"
+	pb "drcache/grpc/definitions"
+	lru "github.com/coocood/freecache"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+var errInvalidCursor = &Error{codes.InvalidArgument, "INVALID_CURSOR", "Invalid scan cursor."}
+
+const (
+	defaultScanCount = 10
+	maxScanWalk      = 10000            // entries walked by one page at most
+	maxScans         = 1024             // iterators kept at most
+	scanIdleTimeout  = 60 * time.Second // an iterator not continued for this long is dropped
+)
+
+type scan struct {
+	namespace string
+	walked    uint64
+	iterator  *lru.Iterator
+	used      time.Time
+}
+
+/*
+Iterators of the scans in progress on this node, by id. An iterator is taken out while a page is read and put back
+under a new id, so a cursor can be continued only once.
+*/
+type scans struct {
+	scans  map[uint64]*scan
+	lastID uint64
+	sync.Mutex
+}
+
+func newScans() *scans {
+	return &scans{scans: make(map[uint64]*scan)}
+}
+
+/*
+Returns the iterator kept under id if it belongs to ns and stopped after walked entries, nil otherwise.
+*/
+func (c *scans) take(id uint64, ns string, walked uint64) *lru.Iterator {
+	c.Lock()
+	defer c.Unlock()
+	kept, ok := c.scans[id]
+	if !ok {
+		return nil
+	}
+	delete(c.scans, id)
+	if kept.namespace != ns || kept.walked != walked {
+		return nil
+	}
+	return kept.iterator
+}
+
+/*
+Keeps iterator and returns its id, dropping the idle ones. When maxScans are kept already the iterator is not kept,
+the scan still continues from its cursor.
+*/
+func (c *scans) put(ns string, walked uint64, iterator *lru.Iterator) uint64 {
+	c.Lock()
+	defer c.Unlock()
+	now := time.Now()
+	for id, kept := range c.scans {
+		if now.Sub(kept.used) > scanIdleTimeout {
+			delete(c.scans, id)
+		}
+	}
+	if len(c.scans) >= maxScans {
+		return 0
+	}
+	c.lastID++
+	c.scans[c.lastID] = &scan{namespace: ns, walked: walked, iterator: iterator, used: now}
+	return c.lastID
+}
+
+/* Pages through the keys of the whole cluster, nodes are visited in address order.
+The cursor holds the node being scanned and the number of entries already walked on it, so a scan can
+continue on any node, and the iterator the node kept to resume from. Keys written during a scan may be missed or returned twice.
+A page may hold fewer keys than asked for, even none, before the scan is complete.
+*/
+func (s *Server) Scan(ctx context.Context, in *pb.ScanRequest) (*pb.ScanReply, error) {
+	count := int(in.Count)
+	if count == 0 {
+		count = defaultScanCount
+	}
+	if in.Local {
+		offset, id, err := parseLocalCursor(in.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		keys, next, done, err := s.scanLocal(in.Namespace, offset, id, count, in.Prefix, in.Pattern)
+		if err != nil {
+			return nil, err
+		}
+		reply := &pb.ScanReply{Keys: keys}
+		if !done {
+			reply.Cursor = next
+		}
+		return reply, nil
+	}
+
+	nodes := s.sortedServers()
+	address, cursor, err := parseScanCursor(in.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	// continue on the first node at or after the cursor's, it may have left the cluster meanwhile
+	i := sort.SearchStrings(nodes, address)
+	if i < len(nodes) && nodes[i] != address {
+		cursor = ""
+	}
+	var keys []string
+	for ; i < len(nodes) && len(keys) < count; i++ {
+		var page *pb.ScanReply
+		if nodes[i] == s.selfAddress {
+			page, err = s.Scan(ctx, &pb.ScanRequest{Cursor: cursor, Prefix: in.Prefix,
+				Pattern: in.Pattern, Count: uint32(count - len(keys)), Local: true, Namespace: in.Namespace})
+		} else {
+			page, err = s.client.ScanItems(nodes[i], &pb.ScanRequest{Cursor: cursor, Prefix: in.Prefix,
+				Pattern: in.Pattern, Count: uint32(count - len(keys)), Local: true, Namespace: in.Namespace})
+			if status.Code(err) == 14 { // Connection Error server is down
+				s.suspectServer(nodes[i])
+			}
+		}
+		if err != nil {
+			log.Printf("Scan of %s failed: %v", nodes[i], err)
+			return nil, err
+		}
+		keys = append(keys, page.Keys...)
+		if page.Cursor != "" {
+			return &pb.ScanReply{Keys: keys, Cursor: nodes[i] + "#" + page.Cursor}, nil
+		}
+		cursor = ""
+	}
+	reply := &pb.ScanReply{Keys: keys}
+	if i < len(nodes) {
+		reply.Cursor = nodes[i] + "#"
+	}
+	return reply, nil
+}
+
+/*
+Walks the namespace's local cache from the offset-th entry. Returns at most count matching keys, the cursor to continue from,
+and whether the end of the cache was reached.
+The iterator is kept between pages, so a page resumes where the previous one stopped. Only when it is gone, because the
+scan was idle too long or is continued on another node, the cache is walked from the start up to offset again.
+A page walks at most maxScanWalk entries, and is returned partial, possibly empty, when it finds fewer matches.
+*/
+func (s *Server) scanLocal(ns string, offset uint64, id uint64, count int, prefix string, pattern string) ([]string, string, bool, error) {
+	cache, err := s.cache(ns)
+	if err != nil {
+		return nil, "", false, err
+	}
+	iterator := s.scans.take(id, ns, offset)
+	if iterator == nil {
+		iterator = cache.NewIterator()
+		for walked := uint64(0); walked < offset; walked++ {
+			if iterator.Next() == nil {
+				break
+			}
+		}
+	}
+	var keys []string
+	walked := offset
+	for item := iterator.Next(); item != nil; item = iterator.Next() {
+		walked++
+		key := string(item.Key)
+		if strings.HasPrefix(key, prefix) && (pattern == "" || globMatch(pattern, key)) {
+			keys = append(keys, key)
+		}
+		if len(keys) == count || walked-offset == maxScanWalk {
+			id = s.scans.put(ns, walked, iterator)
+			return keys, strconv.FormatUint(walked, 10) + "." + strconv.FormatUint(id, 10), false, nil
+		}
+	}
+	return keys, "", true, nil
+}
+
+func (s *Server) sortedServers() []string {
+	s.members.Lock()
+	defer s.members.Unlock()
+	var list []string
+	for address := range s.serverList {
+		list = append(list, address)
+	}
+	sort.Strings(list)
+	return list
+}
+
+/*
+A local cursor is the number of entries walked, followed by the id of the kept iterator.
+*/
+func parseLocalCursor(cursor string) (uint64, uint64, error) {
+	if cursor == "" {
+		return 0, 0, nil
+	}
+	var id uint64
+	i := strings.Index(cursor, ".")
+	if i >= 0 {
+		var err error
+		if id, err = strconv.ParseUint(cursor[i+1:], 10, 64); err != nil {
+			return 0, 0, errInvalidCursor
+		}
+		cursor = cursor[:i]
+	}
+	offset, err := strconv.ParseUint(cursor, 10, 64)
+	if err != nil {
+		return 0, 0, errInvalidCursor
+	}
+	return offset, id, nil
+}
+
+/*
+A cluster cursor is the address of the node being scanned and its local cursor, separated by #.
+*/
+func parseScanCursor(cursor string) (string, string, error) {
+	if cursor == "" {
+		return "", "", nil
+	}
+	i := strings.LastIndex(cursor, "#")
+	if i < 0 {
+		return "", "", errInvalidCursor
+	}
+	if _, _, err := parseLocalCursor(cursor[i+1:]); err != nil {
+		return "", "", err
+	}
+	return cursor[:i], cursor[i+1:], nil
+}
+
+/*
+Matches name against a glob where * matches any run of characters, ? a single character and \ escapes the next one.
+*/
+func globMatch(pattern string, name string) bool {
+	for len(pattern) > 0 {
+		switch pattern[0] {
+		case '*':
+			for len(pattern) > 0 && pattern[0] == '*' {
+				pattern = pattern[1:]
+			}
+			if len(pattern) == 0 {
+				return true
+			}
+			for i := 0; i <= len(name); i++ {
+				if globMatch(pattern, name[i:]) {
+					return true
+				}
+			}
+			return false
+		case '?':
+			if len(name) == 0 {
+				return false
+			}
+		case '\\':
+			if len(pattern) > 1 {
+				pattern = pattern[1:]
+			}
+			fallthrough
+		default:
+			if len(name) == 0 || name[0] != pattern[0] {
+				return false
+			}
+		}
+		pattern = pattern[1:]
+		name = name[1:]
+	}
+	return len(name) == 0
+}
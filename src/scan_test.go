@@ -0,0 +1,158 @@
+package src
+
+import (
+	"context"
+	pb "drcache/grpc/definitions"
+	"fmt"
+	"testing"
+)
+
+func TestGlobMatch(t *testing.T) {
+	tests := []struct {
+		pattern string
+		name    string
+		want    bool
+	}{
+		{"", "", true},
+		{"", "a", false},
+		{"*", "", true},
+		{"*", "anything", true},
+		{"user:*", "user:42", true},
+		{"user:*", "session:42", false},
+		{"*:42", "user:42", true},
+		{"a*b*c", "axxbyyc", true},
+		{"a*b*c", "axxbyy", false},
+		{"**", "a", true},
+		{"?", "a", true},
+		{"?", "", false},
+		{"?", "ab", false},
+		{"a?c", "abc", true},
+		{`\*`, "*", true},
+		{`\*`, "a", false},
+		{`a\?`, "a?", true},
+		{`a\?`, "ab", false},
+	}
+	for _, test := range tests {
+		if got := globMatch(test.pattern, test.name); got != test.want {
+			t.Errorf("globMatch(%q, %q) = %v, want %v", test.pattern, test.name, got, test.want)
+		}
+	}
+}
+
+func TestParseScanCursor(t *testing.T) {
+	tests := []struct {
+		cursor  string
+		address string
+		local   string
+		err     bool
+	}{
+		{"", "", "", false},
+		{"127.0.0.1:7001#", "127.0.0.1:7001", "", false},
+		{"127.0.0.1:7001#14.2", "127.0.0.1:7001", "14.2", false},
+		{"127.0.0.1:7001#14", "127.0.0.1:7001", "14", false},
+		{"127.0.0.1:7001", "", "", true},
+		{"127.0.0.1:7001#x", "", "", true},
+		{"127.0.0.1:7001#14.x", "", "", true},
+	}
+	for _, test := range tests {
+		address, local, err := parseScanCursor(test.cursor)
+		if (err != nil) != test.err || address != test.address || local != test.local {
+			t.Errorf("parseScanCursor(%q) = %q, %q, %v", test.cursor, address, local, err)
+		}
+	}
+}
+
+func TestParseLocalCursor(t *testing.T) {
+	tests := []struct {
+		cursor string
+		offset uint64
+		id     uint64
+		err    bool
+	}{
+		{"", 0, 0, false},
+		{"7", 7, 0, false},
+		{"7.3", 7, 3, false},
+		{".3", 0, 0, true},
+		{"7.", 0, 0, true},
+	}
+	for _, test := range tests {
+		offset, id, err := parseLocalCursor(test.cursor)
+		if (err != nil) != test.err || offset != test.offset || id != test.id {
+			t.Errorf("parseLocalCursor(%q) = %v, %v, %v", test.cursor, offset, id, err)
+		}
+	}
+}
+
+func TestScansResumeOnce(t *testing.T) {
+	c := newScans()
+	id := c.put("ns", 10, nil)
+	if c.take(id, "other", 10) != nil || c.take(id, "ns", 10) != nil {
+		t.Fatal("an iterator was kept for another namespace")
+	}
+	cache := newNamespaces(0).spaces[""].cache
+	iterator := cache.NewIterator()
+	id = c.put("ns", 10, iterator)
+	if c.take(id, "ns", 11) != nil {
+		t.Fatal("an iterator was resumed at another position")
+	}
+	id = c.put("ns", 10, iterator)
+	if c.take(id, "ns", 10) != iterator {
+		t.Fatal("the kept iterator was not resumed")
+	}
+	if c.take(id, "ns", 10) != nil {
+		t.Fatal("an iterator was resumed twice")
+	}
+}
+
+func TestScanAcrossNodes(t *testing.T) {
+	first, second := startCluster(t)
+	for i := 0; i < 50; i++ {
+		setString(t, first.Server, fmt.Sprint("user:", i), "v")
+		setString(t, first.Server, fmt.Sprint("session:", i), "v")
+	}
+	if keys := scanAll(t, second.Server, &pb.ScanRequest{Prefix: "user:", Count: 7}); len(keys) != 50 {
+		t.Errorf("prefix scan returned %v keys, want 50", len(keys))
+	}
+	keys := scanAll(t, first.Server, &pb.ScanRequest{Pattern: "session:4?", Count: 100})
+	for i := 40; i < 50; i++ {
+		if !keys[fmt.Sprint("session:", i)] {
+			t.Errorf("pattern scan missed session:%v", i)
+		}
+	}
+	if len(keys) != 10 {
+		t.Errorf("pattern scan returned %v", keys)
+	}
+	if _, err := first.Scan(context.Background(), &pb.ScanRequest{Cursor: "garbage"}); err == nil {
+		t.Error("an invalid cursor was accepted")
+	}
+}
+
+/*
+Pages through a scan, failing on a key returned twice or a page over the count.
+*/
+func scanAll(t *testing.T, s *Server, in *pb.ScanRequest) map[string]bool {
+	t.Helper()
+	keys := make(map[string]bool)
+	for pages := 0; ; pages++ {
+		if pages > 1000 {
+			t.Fatal("scan does not end")
+		}
+		reply, err := s.Scan(context.Background(), in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(reply.Keys) > int(in.Count) {
+			t.Errorf("page of %v keys, want at most %v", len(reply.Keys), in.Count)
+		}
+		for _, key := range reply.Keys {
+			if keys[key] {
+				t.Errorf("scan returned %v twice", key)
+			}
+			keys[key] = true
+		}
+		if reply.Cursor == "" {
+			return keys
+		}
+		in.Cursor = reply.Cursor
+	}
+}
@@ -7,43 +7,71 @@ import (
 	"errors"
 	lru "github.com/coocood/freecache"
 	"google.golang.org/grpc/status"
+	"hash/crc32"
 	"log"
 	"sync"
+	"sync/atomic"
+	"time"
 )
 
-var cacheMissError = errors.New("Key does not exist.")
+const keyLockCount = 256
+
+const handOffWorkers = 16 // concurrent HandOff requests of a handoff
 
 type Server struct {
-	lru         *lru.Cache
-	ch          *consistent_hashing.Ring
+	namespaces  *namespaces
+	ch          atomic.Value        // *consistent_hashing.Ring, replaced on every membership change
+	ringChanged chan struct{}       // closed when the ring is replaced, guarded by members
 	serverList  map[string]struct{} // set of servers
 	selfAddress string
 	client      *Client
-	sync.Mutex
+	version     uint64                   // last CAS version handed out, accessed atomically
+	keyLocks    [keyLockCount]sync.Mutex // serializes read-modify-write operations on local keys
+	watchers    *watchHub
+	tags        *tagIndex
+	requests    *counters // requests served, per RPC
+	loaders     *loaders
+	loading     *flightGroup
+	leases      *leases
+	lists       *listWaiters // blocked pops waiting for a push
+	broker      *broker
+	membership  *membership
+	scans       *scans     // iterators of scans in progress on this node
+	ready       int32      // set once the node has joined the cluster, accessed atomically
+	clusterKey  string     // shared by the members of the cluster, identifies requests from peers
+	members     sync.Mutex // guards serverList and ch
 }
 
 /* With consistent hashing check if key belongs to you, if so add to local cache. Otherwise send to other server with client
    Adds if key does not exist already.
-   If key exists, returns error
+   If key exists, returns AlreadyExists
 */
 func (s *Server) Add(ctx context.Context, in *pb.AddRequest) (*pb.Reply, error) {
 	key := in.Item.Key
 	log.Printf("Received: %v", key)
 	value := in.Item.Value
 	expiration := in.Item.Expiration
-	nodeAddress := s.ch.Get(key)
+	nodeAddress := s.ring().Get(key)
 	if nodeAddress == s.selfAddress {
-		getval, _ := s.lru.Get([]byte(key))
-		if getval != nil {
-			return &pb.Reply{Message: "Key already exists."}, nil
+		lock := s.keyLock(key)
+		lock.Lock()
+		defer lock.Unlock()
+		if err := s.checkStringWrite(in.Namespace, key, in.Item.Kind); err != nil {
+			return nil, err
+		}
+		_, err := s.peekLocal(in.Namespace, key)
+		if err == nil {
+			return nil, ErrAlreadyExists
+		} else if err != ErrNotFound {
+			return nil, err
 		} else {
-			err := s.lru.Set([]byte(key), value, int(expiration))
-			return &pb.Reply{Message: "ok"}, err
+			item, err := s.setLocal(in.Namespace, key, pb.Kind_STRING, value, expiration, in.Item.Tags)
+			return &pb.Reply{Message: "ok", Item: item}, err
 		}
 	} else {
 		reply, err := s.client.AddItem(nodeAddress, in)
 		if status.Code(err) == 14 { // Connection Error server is down
-			s.dropAndReInit(nodeAddress)
+			s.suspectServer(nodeAddress)
 		}
 		return reply, err
 	}
@@ -52,37 +80,80 @@ func (s *Server) Add(ctx context.Context, in *pb.AddRequest) (*pb.Reply, error)
 /* With consistent hashing check if key belongs to you, if so add to local cache. Otherwise send to other server with client
 If entry does not exist, adds the entry.
 If exists updates the entry's value.
+A set carrying a lease is only accepted while the lease is valid, a Delete or a write since revokes it.
 */
 func (s *Server) Set(ctx context.Context, in *pb.SetRequest) (*pb.Reply, error) {
 	key := in.Item.Key
 	log.Printf("Received: %v", key)
 	value := in.Item.Value
 	expiration := in.Item.Expiration
-	nodeAddress := s.ch.Get(key)
+	nodeAddress := s.ring().Get(key)
 	if nodeAddress == s.selfAddress {
-		err := s.lru.Set([]byte(key), value, int(expiration))
-		return &pb.Reply{Message: "ok"}, err
+		lock := s.keyLock(key)
+		lock.Lock()
+		defer lock.Unlock()
+		if err := s.checkStringWrite(in.Namespace, key, in.Item.Kind); err != nil {
+			return nil, err
+		}
+		if in.Lease != 0 && !s.leases.consume(namespacedKey(in.Namespace, key), in.Lease) {
+			return nil, ErrLeaseInvalid
+		}
+		item, err := s.setLocal(in.Namespace, key, pb.Kind_STRING, value, expiration, in.Item.Tags)
+		return &pb.Reply{Message: "ok", Item: item}, err
 	} else {
 		reply, err := s.client.SetItem(nodeAddress, in)
 		if status.Code(err) == 14 { // Connection Error server is down
-			s.dropAndReInit(nodeAddress)
+			s.suspectServer(nodeAddress)
 		}
 		return reply, err
 	}
 }
 
-/* With consistent hashing check if key belongs to you, if so add to local cache. Otherwise send to other server with client
+/* With consistent hashing check if key belongs to you, if so swap in local cache. Otherwise send to other server with client
 If entry does not exist, return error.
-If exists deletes the entry
+If the entry's version differs from the item's lastUpdate, the entry is left untouched and returns FailedPrecondition.
+Otherwise updates the entry's value and returns the new version.
+*/
+func (s *Server) CompareAndSwap(ctx context.Context, in *pb.CompareAndSwapRequest) (*pb.Reply, error) {
+	key := in.Item.Key
+	log.Printf("Received: %v", key)
+	nodeAddress := s.ring().Get(key)
+	if nodeAddress == s.selfAddress {
+		lock := s.keyLock(key)
+		lock.Lock()
+		defer lock.Unlock()
+		current, err := s.getLocal(in.Namespace, key)
+		if err != nil {
+			return nil, err
+		}
+		if current.LastUpdate != in.Item.LastUpdate {
+			return nil, ErrModified
+		}
+		if err := s.checkStringWrite(in.Namespace, key, in.Item.Kind); err != nil {
+			return nil, err
+		}
+		item, err := s.setLocal(in.Namespace, key, pb.Kind_STRING, in.Item.Value, in.Item.Expiration, in.Item.Tags)
+		return &pb.Reply{Message: "ok", Item: item}, err
+	} else {
+		reply, err := s.client.CompareAndSwapItem(nodeAddress, in)
+		if status.Code(err) == 14 { // Connection Error server is down
+			s.suspectServer(nodeAddress)
+		}
+		return reply, err
+	}
+}
+
+/* With consistent hashing check if key belongs to you, if so add to local cache. Otherwise send to other server with client
+If entry does not exist, returns NotFound.
+If exists deletes the entry, unless it is a lock
 */
 func (s *Server) Delete(ctx context.Context, in *pb.DeleteRequest) (*pb.Reply, error) {
-	nodeAddress := s.ch.Get(in.Key)
+	nodeAddress := s.ring().Get(in.Key)
 	if nodeAddress == s.selfAddress {
-		ret := s.lru.Del([]byte(in.Key))
-		if ret {
-			return &pb.Reply{Message: "ok"}, nil
+		if err := s.deleteKey(in.Namespace, in.Key); err != nil {
+			return nil, err
 		}
-		return nil, errors.New("not found")
+		return &pb.Reply{Message: "ok"}, nil
 
 	} else {
 		return s.client.DeleteItem(nodeAddress, in)
@@ -90,79 +161,574 @@ func (s *Server) Delete(ctx context.Context, in *pb.DeleteRequest) (*pb.Reply, e
 }
 
 /*
-flushes all cache
+flushes all cache of the namespace on every node, after the delay if given
+every node flushes at the same time, the reply tells which nodes flushed or scheduled the flush
 */
-func (s *Server) DeleteAll(ctx context.Context, in *pb.DeleteAllRequest) (*pb.Reply, error) {
-	s.lru.Clear()
-	return &pb.Reply{Message: "ok"}, nil
+func (s *Server) DeleteAll(ctx context.Context, in *pb.DeleteAllRequest) (*pb.ClusterReply, error) {
+	flushAt := in.FlushAt
+	if flushAt == 0 && in.Delay > 0 {
+		flushAt = time.Now().Add(time.Duration(in.Delay)*time.Second).UnixNano() / int64(time.Millisecond)
+	}
+	if in.Local {
+		if err := s.flushLocal(in.Namespace, flushAt); err != nil {
+			return nil, err
+		}
+		return &pb.ClusterReply{Nodes: []*pb.NodeResult{nodeResult(s.selfAddress, 0, nil)}}, nil
+	}
+	nodes := s.broadcast(func() (uint64, error) {
+		return 0, s.flushLocal(in.Namespace, flushAt)
+	}, func(address string) (uint64, error) {
+		_, err := s.client.DeleteAll(address, &pb.DeleteAllRequest{Namespace: in.Namespace, Local: true, FlushAt: flushAt})
+		return 0, err
+	})
+	return &pb.ClusterReply{Nodes: nodes}, nil
 }
 
+func (s *Server) flushLocal(ns string, flushAt int64) error {
+	cache, err := s.cache(ns)
+	if err != nil {
+		return err
+	}
+	wait := time.Until(time.Unix(0, flushAt*int64(time.Millisecond)))
+	if flushAt == 0 || wait <= 0 {
+		s.flush(ns, cache)
+	} else {
+		time.AfterFunc(wait, func() {
+			s.flush(ns, cache)
+		})
+	}
+	return nil
+}
+
+/*
+Drops every entry of the namespace with their tags and leases, and publishes a DELETE event for every watched key.
+Writes are held off with all key locks, so that a write is either flushed and its DELETE published, or kept.
+*/
+func (s *Server) flush(ns string, cache *lru.Cache) {
+	for i := range s.keyLocks {
+		s.keyLocks[i].Lock()
+	}
+	defer func() {
+		for i := range s.keyLocks {
+			s.keyLocks[i].Unlock()
+		}
+	}()
+	var watched []string
+	if s.watchers.watching(ns) {
+		iterator := cache.NewIterator()
+		for item := iterator.Next(); item != nil; item = iterator.Next() {
+			if key := string(item.Key); s.watchers.watched(ns, key) {
+				watched = append(watched, key)
+			}
+		}
+	}
+	cache.Clear()
+	s.tags.clear(ns)
+	s.leases.revokeNamespace(ns)
+	for _, key := range watched {
+		s.notifyDelete(ns, key)
+	}
+}
+
+/* With consistent hashing check if key belongs to you, if so read from local cache. Otherwise send to other server with client
+If entry does not exist and load is set, the loader registered for the key fills it, once for all concurrent misses.
+If entry does not exist and lease is set, the first caller gets a lease to fill it with Set, the others get Aborted
+until the lease is used or expires.
+*/
 func (s *Server) Get(ctx context.Context, in *pb.GetRequest) (*pb.Reply, error) {
-	nodeAddress := s.ch.Get(in.Key)
+	nodeAddress := s.ring().Get(in.Key)
 	if nodeAddress == s.selfAddress {
-		val, exp, err := s.lru.GetWithExpiration([]byte(in.Key))
+		item, err := s.getLocal(in.Namespace, in.Key)
+		if err == ErrNotFound && in.Load {
+			item, err = s.loadLocal(in.Namespace, in.Key)
+		}
+		if err == ErrNotFound && in.Lease {
+			token, err := s.leases.grant(namespacedKey(in.Namespace, in.Key))
+			if err != nil {
+				return nil, err
+			}
+			return &pb.Reply{Message: "ok", Lease: token}, nil
+		}
 		if err == nil {
-			return &pb.Reply{Message: "ok", Item: &pb.Item{Key: in.Key, Value: val, Expiration: exp}}, nil
+			return &pb.Reply{Message: "ok", Item: item}, nil
 		}
 		return nil, err
 	} else {
 		reply, err := s.client.GetItem(nodeAddress, in)
 		if status.Code(err) == 14 { // Connection Error server is down
-			s.dropAndReInit(nodeAddress)
+			s.suspectServer(nodeAddress)
 		}
 		return reply, err
 	}
 }
 
+/* Adds the server to the ring, and replies once the local keys it now owns have been handed over to it.
+A joining server calls it on every member, so that it holds its keys before it starts serving.
+The member is recorded in the gossip membership directly, applyUpdates would start a second handoff.
+Fails when the server lacks a namespace of the keys, as it would never receive them. Only peers may call it.
+*/
 func (s *Server) AddServer(ctx context.Context, in *pb.AddServerRequest) (*pb.Reply, error) {
-	s.Lock()
-	defer s.Unlock()
-	s.serverList[in.Address] = struct{}{}
-	s.ch = consistent_hashing.NewRing(s.serverList)
-	iterator := s.lru.NewIterator()
-	item := iterator.Next()
-	for item != nil {
+	log.Printf("Received add server: %v", in.Address)
+	if !s.fromPeer(ctx) {
+		return nil, errPeerOnly
+	}
+	s.membership.apply(&pb.MemberUpdate{Address: in.Address, State: pb.MemberUpdate_ALIVE})
+	s.addMember(in.Address)
+	if _, err := s.handOff(); err != nil {
+		return nil, err
+	}
+	return &pb.Reply{Message: "ok"}, nil
+}
+
+/*
+Adds the server to the ring, reports whether it was not a member yet.
+*/
+func (s *Server) addMember(address string) bool {
+	s.members.Lock()
+	defer s.members.Unlock()
+	if _, ok := s.serverList[address]; ok {
+		return false
+	}
+	s.serverList[address] = struct{}{}
+	s.setRing()
+	return true
+}
+
+/*
+Sends the local keys that the ring assigns to other servers to their owner, as stored and with their remaining expiration,
+and returns once every transfer is done. A key is dropped once its owner has stored it, or already holds a newer write.
+Keys are sent by handOffWorkers workers, so that a node handing over millions of keys has a bounded number of RPCs in flight.
+Returns the number of keys that could not be sent, and errNamespaceNotFound if an owner lacks one of the namespaces.
+*/
+func (s *Server) handOff() (uint64, error) {
+	type handOffJob struct {
+		name    string
+		cache   *lru.Cache
+		item    *lru.Entry
+		newNode string
+	}
+	var failed uint64
+	var missing error
+	var firstMissing sync.Once
+	var wg sync.WaitGroup
+	jobs := make(chan handOffJob)
+	for i := 0; i < handOffWorkers; i++ {
+		wg.Add(1)
 		go func() {
-			newNode := s.ch.Get(string(item.Key))
-			if newNode != s.selfAddress {
-				_, err := s.client.AddItem(newNode, &pb.AddRequest{Item: &pb.Item{Key: string(item.Key), Value: item.Value}})
-				if err == nil {
-					s.lru.Del(item.Key)
+			defer wg.Done()
+			for job := range jobs {
+				e, err := decodeEntry(job.item.Value)
+				if err != nil || e.expired() {
+					continue
+				}
+				_, err = s.client.HandOffItem(job.newNode, &pb.HandOffRequest{Key: string(job.item.Key), Entry: job.item.Value, Expiration: remainingTTL(e.expireAt), Namespace: job.name})
+				if err == nil || errors.Is(err, ErrAlreadyExists) {
+					job.cache.Del(job.item.Key)
+					continue
+				}
+				atomic.AddUint64(&failed, 1)
+				if errors.Is(err, errNamespaceNotFound) {
+					firstMissing.Do(func() {
+						log.Printf("%v lacks namespace %q", job.newNode, job.name)
+						missing = err
+					})
 				}
 			}
 		}()
-		item = iterator.Next()
 	}
-	return nil, nil
+	for name, space := range s.namespaces.all() {
+		iterator := space.cache.NewIterator()
+		for item := iterator.Next(); item != nil; item = iterator.Next() {
+			newNode := s.ring().Get(string(item.Key))
+			if newNode != s.selfAddress {
+				jobs <- handOffJob{name: name, cache: space.cache, item: item, newNode: newNode}
+			}
+		}
+	}
+	close(jobs)
+	wg.Wait()
+	return failed, missing
+}
+
+/*
+Stores an entry handed over by its previous owner as is, keeping its version, kind and tags. Only peers may call it.
+A key written on this node meanwhile is kept, and AlreadyExists is returned.
+*/
+func (s *Server) HandOff(ctx context.Context, in *pb.HandOffRequest) (*pb.Reply, error) {
+	if !s.fromPeer(ctx) {
+		return nil, errPeerOnly
+	}
+	e, err := decodeEntry(in.Entry)
+	if err != nil {
+		return nil, err
+	}
+	cache, err := s.cache(in.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	lock := s.keyLock(in.Key)
+	lock.Lock()
+	defer lock.Unlock()
+	if _, err := s.peekLocal(in.Namespace, in.Key); err == nil {
+		return nil, ErrAlreadyExists
+	} else if err != ErrNotFound {
+		return nil, err
+	}
+	s.observeVersion(e.version)
+	if err := s.storeLocal(cache, in.Namespace, in.Key, e, in.Expiration); err != nil {
+		return nil, err
+	}
+	return &pb.Reply{Message: "ok"}, nil
 }
 
 func (s *Server) GetServers(ctx context.Context, in *pb.GetServersRequest) (*pb.ServerList, error) {
+	s.members.Lock()
+	defer s.members.Unlock()
 	var list []string
 	for address := range s.serverList {
 		list = append(list, address)
 	}
-	return &pb.ServerList{Servers: list}, nil
+	namespaces := make(map[string]uint64)
+	for name, space := range s.namespaces.all() {
+		if name != "" {
+			namespaces[name] = space.quota
+		}
+	}
+	return &pb.ServerList{Servers: list, Namespaces: namespaces}, nil
 }
 
+/* Declares the server dead, a leaving server calls it on every member. Only peers may call it.
+*/
 func (s *Server) DropServer(ctx context.Context, in *pb.DropServerRequest) (*pb.Reply, error) {
-	s.dropAndReInit(in.Server)
-	return nil, nil
+	if !s.fromPeer(ctx) {
+		return nil, errPeerOnly
+	}
+	s.dropServer(in.Server)
+	return &pb.Reply{Message: "ok"}, nil
+}
+
+/*
+The members of a cluster must share clusterKey, a node without one can not join a cluster nor be joined.
+*/
+func NewServer(ipList map[string]struct{}, maxSize int, localAddress string, clusterKey string) *Server {
+	s := &Server{namespaces: newNamespaces(maxSize), ringChanged: make(chan struct{}), serverList: ipList, selfAddress: localAddress, client: newClient(ipList, localAddress, clusterKey),
+		version: uint64(time.Now().UnixNano()), watchers: newWatchHub(), tags: newTagIndex(),
+		requests: newCounters(), loaders: newLoaders(), loading: newFlightGroup(),
+		leases: newLeases(), lists: newListWaiters(), broker: newBroker(), membership: newMembership(localAddress, ipList),
+		scans: newScans(), clusterKey: clusterKey}
+	s.ch.Store(consistent_hashing.NewRing(ipList))
+	go s.sweepTags()
+	go s.leases.expire()
+	go s.gossip()
+	return s
+}
+
+func namespacedKey(ns string, key string) string {
+	return ns + "\x00" + key
+}
+
+func (s *Server) keyLock(key string) *sync.Mutex {
+	return &s.keyLocks[crc32.ChecksumIEEE([]byte(key))%keyLockCount]
 }
 
-func NewServer(ipList map[string]struct{}, maxSize int, localAddress string) *Server {
-	cache := lru.NewCache(maxSize)
-	ch := consistent_hashing.NewRing(ipList)
-	return &Server{lru: cache, ch: ch, serverList: ipList, selfAddress: localAddress, client: NewClient(ipList, localAddress)}
+/*
+Stores the value under a fresh version. Callers must hold the key lock.
+*/
+func (s *Server) setLocal(ns string, key string, kind pb.Kind, value []byte, expiration uint32, tags []string) (*pb.Item, error) {
+	cache, err := s.cache(ns)
+	if err != nil {
+		return nil, err
+	}
+	e := &entry{version: atomic.AddUint64(&s.version, 1), kind: kind, tags: tags, value: value}
+	if err := s.storeLocal(cache, ns, key, e, expiration); err != nil {
+		return nil, err
+	}
+	return &pb.Item{Key: key, LastUpdate: e.version, Kind: kind}, nil
 }
 
-func (s *Server) dropAndReInit(deadNode string) {
-	s.Lock()
-	defer s.Unlock()
-	delete(s.serverList, deadNode)
-	s.ch = consistent_hashing.NewRing(s.serverList)
-	for address, _ := range s.serverList {
+/*
+Stores the entry as is, expiring after expiration seconds, and updates the tag index, leases and waiters of the key.
+Callers must hold the key lock.
+*/
+func (s *Server) storeLocal(cache *lru.Cache, ns string, key string, e *entry, expiration uint32) error {
+	// an expired entry is replaced too, and its tags are still indexed
+	var old *entry
+	if raw, err := cache.Peek([]byte(key)); err == nil {
+		old, _ = decodeEntry(raw)
+	}
+	e.expireAt = expiresAt(expiration)
+	if err := cache.Set([]byte(key), e.encode(), int(expiration)); err != nil {
+		return cacheError(err)
+	}
+	if old != nil {
+		s.tags.remove(ns, key, old.tags)
+	}
+	s.tags.add(ns, key, e.tags)
+	s.leases.revoke(namespacedKey(ns, key))
+	if e.kind == pb.Kind_LIST {
+		s.lists.notify(namespacedKey(ns, key))
+	}
+	s.notifySet(ns, &pb.Item{Key: key, Value: e.value, LastUpdate: e.version, Tags: e.tags, Kind: e.kind}, expiration)
+	return nil
+}
+
+/*
+Raises the last version handed out to at least version, so that versions handed over from another node are not reused.
+*/
+func (s *Server) observeVersion(version uint64) {
+	for {
+		last := atomic.LoadUint64(&s.version)
+		if last >= version || atomic.CompareAndSwapUint64(&s.version, last, version) {
+			return
+		}
+	}
+}
+
+/*
+Deletes a key on behalf of Delete and MultiDelete. Locks are only released with Unlock, deleting one returns errWrongKind.
+The lease of a missing key is revoked too: its holder read the key before the Delete and must not fill it after.
+*/
+func (s *Server) deleteKey(ns string, key string) error {
+	lock := s.keyLock(key)
+	lock.Lock()
+	defer lock.Unlock()
+	current, err := s.peekLocal(ns, key)
+	if err != nil {
+		s.leases.revoke(namespacedKey(ns, key))
+		return err
+	}
+	if current.kind == pb.Kind_LOCK {
+		return errWrongKind
+	}
+	if !s.deleteLocked(ns, key, nil) {
+		return ErrNotFound
+	}
+	return nil
+}
+
+/*
+Set, Add, CompareAndSwap and MultiSet only write strings, and never over a key of another kind. Other kinds are only
+written by the commands of their data type, so that a Set can not forge a lock or clobber a list.
+Callers must hold the key lock.
+*/
+func (s *Server) checkStringWrite(ns string, key string, kind pb.Kind) error {
+	if kind != pb.Kind_STRING {
+		return errWrongKind
+	}
+	if current, err := s.peekLocal(ns, key); err == nil && current.kind != pb.Kind_STRING {
+		return errWrongKind
+	}
+	return nil
+}
+
+/*
+Deletes the entry if it exists and, when a condition is given, satisfies it. Takes the key lock.
+*/
+func (s *Server) deleteLocalIf(ns string, key string, condition func(*entry) bool) bool {
+	lock := s.keyLock(key)
+	lock.Lock()
+	defer lock.Unlock()
+	return s.deleteLocked(ns, key, condition)
+}
+
+/*
+Same as deleteLocalIf, for callers that already hold the key lock.
+*/
+func (s *Server) deleteLocked(ns string, key string, condition func(*entry) bool) bool {
+	cache, err := s.cache(ns)
+	if err != nil {
+		return false
+	}
+	old, err := s.peekLocal(ns, key)
+	if err != nil || (condition != nil && !condition(old)) || !cache.Del([]byte(key)) {
+		return false
+	}
+	s.tags.remove(ns, key, old.tags)
+	s.leases.revoke(namespacedKey(ns, key))
+	s.notifyDelete(ns, key)
+	return true
+}
+
+/*
+Reads the entry without counting a hit or a miss. freecache's Peek returns expired entries, they are reported with ErrNotFound.
+*/
+func (s *Server) peekLocal(ns string, key string) (*entry, error) {
+	cache, err := s.cache(ns)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := cache.Peek([]byte(key))
+	if err != nil {
+		return nil, cacheError(err)
+	}
+	e, err := decodeEntry(raw)
+	if err != nil {
+		return nil, err
+	}
+	if e.expired() {
+		return nil, ErrNotFound
+	}
+	return e, nil
+}
+
+func (s *Server) getLocal(ns string, key string) (*pb.Item, error) {
+	cache, err := s.cache(ns)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := cache.Get([]byte(key))
+	if err != nil {
+		return nil, cacheError(err)
+	}
+	e, err := decodeEntry(raw)
+	if err != nil {
+		return nil, err
+	}
+	if e.expired() {
+		return nil, ErrNotFound
+	}
+	return &pb.Item{Key: key, Value: e.value, LastUpdate: e.version, Expiration: e.expireAt, Tags: e.tags, Kind: e.kind}, nil
+}
+
+/*
+Reads a local value of a data type, a missing key is reported with ErrNotFound.
+*/
+func (s *Server) readKind(ns string, key string, kind pb.Kind) (*pb.Item, error) {
+	item, err := s.getLocal(ns, key)
+	if err != nil {
+		return nil, err
+	}
+	if item.Kind != kind {
+		return nil, errWrongKind
+	}
+	return item, nil
+}
+
+/*
+Read-modify-write of a local value of a data type under the key lock. update receives the current value, nil when
+the key does not exist, and returns the new one; an empty value deletes the key. An existing key keeps its
+remaining expiration and tags, a created one gets the given expiration.
+*/
+func (s *Server) updateKind(ns string, key string, kind pb.Kind, expiration uint32, update func([]byte) ([]byte, error)) (*pb.Item, error) {
+	lock := s.keyLock(key)
+	lock.Lock()
+	defer lock.Unlock()
+	var value []byte
+	var tags []string
+	current, err := s.getLocal(ns, key)
+	if err == nil {
+		if current.Kind != kind {
+			return nil, errWrongKind
+		}
+		value, expiration, tags = current.Value, remainingTTL(current.Expiration), current.Tags
+	} else if err != ErrNotFound {
+		return nil, err
+	}
+	value, err = update(value)
+	if err != nil {
+		return nil, err
+	}
+	if len(value) == 0 {
+		s.deleteLocked(ns, key, nil)
+		return &pb.Item{Key: key, Kind: kind}, nil
+	}
+	return s.setLocal(ns, key, kind, value, expiration, tags)
+}
+
+/*
+Maps freecache errors to the errors reported to callers.
+*/
+func cacheError(err error) error {
+	switch err {
+	case lru.ErrNotFound:
+		return ErrNotFound
+	case lru.ErrLargeKey, lru.ErrLargeEntry:
+		return ErrTooLarge
+	}
+	return err
+}
+
+/*
+Converts the expireAt of an entry back to the seconds left, 0 means no expiration.
+*/
+func remainingTTL(expireAt uint32) uint32 {
+	if expireAt == 0 {
+		return 0
+	}
+	now := uint32(time.Now().Unix())
+	if expireAt <= now {
+		return 1
+	}
+	return expireAt - now
+}
+
+/*
+Returns the current ring. Membership changes replace the ring rather than modify it, so it can be read without locking.
+*/
+func (s *Server) ring() *consistent_hashing.Ring {
+	return s.ch.Load().(*consistent_hashing.Ring)
+}
+
+/*
+Replaces the ring with one built from serverList, and wakes up the streams waiting for a ring change.
+Called with members held.
+*/
+func (s *Server) setRing() {
+	s.ch.Store(consistent_hashing.NewRing(s.serverList))
+	close(s.ringChanged)
+	s.ringChanged = make(chan struct{})
+}
+
+/*
+Returns a channel that is closed on the next ring change.
+*/
+func (s *Server) ringChange() <-chan struct{} {
+	s.members.Lock()
+	defer s.members.Unlock()
+	return s.ringChanged
+}
+
+/*
+Returns every other member of the cluster.
+*/
+func (s *Server) peers() []string {
+	s.members.Lock()
+	defer s.members.Unlock()
+	var list []string
+	for address := range s.serverList {
 		if address != s.selfAddress {
-			go s.client.DropServer(address)
+			list = append(list, address)
 		}
 	}
+	return list
+}
+
+/*
+Declares the node dead without probing it, for nodes that leave or are removed by an operator.
+The rest of the cluster learns about it by gossip.
+*/
+func (s *Server) dropServer(deadNode string) {
+	s.applyUpdates([]*pb.MemberUpdate{s.membership.declare(deadNode, pb.MemberUpdate_DEAD)})
+}
+
+/*
+Called when a request to a peer fails with Unavailable. One failure may be a blip, so the peer is only suspected
+if it answers neither a direct nor an indirect probe, and it leaves the ring only if it does not refute the
+suspicion within suspicionTimeout. Failures reported while the peer is being checked are ignored.
+*/
+func (s *Server) suspectServer(address string) {
+	if !s.membership.startCheck(address) {
+		return
+	}
+	go func() {
+		defer s.membership.endCheck(address)
+		s.probe(address)
+	}()
+}
+
+func (s *Server) removeMember(address string) {
+	s.members.Lock()
+	defer s.members.Unlock()
+	delete(s.serverList, address)
+	s.setRing()
 }
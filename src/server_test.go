@@ -0,0 +1,226 @@
+package src
+
+import (
+	"context"
+	pb "drcache/grpc/definitions"
+	"errors"
+	"fmt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"net"
+	"testing"
+	"time"
+)
+
+const testClusterKey = "test-cluster-key"
+
+/*
+Returns a server that owns every key, for tests that do not need a cluster. It is never dialled.
+*/
+func newTestServer(t *testing.T) *Server {
+	t.Helper()
+	address := "127.0.0.1:0"
+	return NewServer(map[string]struct{}{address: {}}, minNamespaceQuota, address, testClusterKey)
+}
+
+func setString(t *testing.T, s *Server, key string, value string) *pb.Item {
+	t.Helper()
+	reply, err := s.Set(context.Background(), &pb.SetRequest{Item: &pb.Item{Key: key, Value: []byte(value)}})
+	if err != nil {
+		t.Fatalf("set %v: %v", key, err)
+	}
+	return reply.Item
+}
+
+func getString(t *testing.T, s *Server, key string) (string, error) {
+	t.Helper()
+	reply, err := s.Get(context.Background(), &pb.GetRequest{Key: key})
+	if err != nil {
+		return "", err
+	}
+	return string(reply.Item.Value), nil
+}
+
+func TestCompareAndSwap(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	first := setString(t, s, "key", "first")
+	second := setString(t, s, "key", "second")
+	if second.LastUpdate <= first.LastUpdate {
+		t.Fatalf("version %v of the second write is not above %v", second.LastUpdate, first.LastUpdate)
+	}
+
+	_, err := s.CompareAndSwap(ctx, &pb.CompareAndSwapRequest{Item: &pb.Item{Key: "key", Value: []byte("stale"), LastUpdate: first.LastUpdate}})
+	if !errors.Is(err, ErrModified) {
+		t.Errorf("swap with a stale version returned %v", err)
+	}
+	reply, err := s.CompareAndSwap(ctx, &pb.CompareAndSwapRequest{Item: &pb.Item{Key: "key", Value: []byte("swapped"), LastUpdate: second.LastUpdate}})
+	if err != nil {
+		t.Fatalf("swap with the current version: %v", err)
+	}
+	if reply.Item.LastUpdate <= second.LastUpdate {
+		t.Errorf("swap kept version %v", reply.Item.LastUpdate)
+	}
+	if value, _ := getString(t, s, "key"); value != "swapped" {
+		t.Errorf("value after swap is %q", value)
+	}
+	_, err = s.CompareAndSwap(ctx, &pb.CompareAndSwapRequest{Item: &pb.Item{Key: "key", Value: []byte("again"), LastUpdate: second.LastUpdate}})
+	if !errors.Is(err, ErrModified) {
+		t.Errorf("second swap with the same version returned %v", err)
+	}
+	_, err = s.CompareAndSwap(ctx, &pb.CompareAndSwapRequest{Item: &pb.Item{Key: "missing", Value: []byte("v"), LastUpdate: 1}})
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("swap of a missing key returned %v", err)
+	}
+}
+
+func TestGetReturnsVersion(t *testing.T) {
+	s := newTestServer(t)
+	item := setString(t, s, "key", "value")
+	reply, err := s.Get(context.Background(), &pb.GetRequest{Key: "key"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reply.Item.LastUpdate != item.LastUpdate {
+		t.Errorf("Get returned version %v, Set %v", reply.Item.LastUpdate, item.LastUpdate)
+	}
+}
+
+type testNode struct {
+	*Server
+	grpc *grpc.Server
+}
+
+/*
+Starts a node on a free local port, serving as main does. Nodes of a test share the Client, as they would not in production.
+*/
+func startNode(t *testing.T) *testNode {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	address := lis.Addr().String()
+	s := NewServer(map[string]struct{}{address: {}}, minNamespaceQuota, address, testClusterKey)
+	g := grpc.NewServer(grpc.UnaryInterceptor(s.GateUnary), grpc.StreamInterceptor(s.GateStream))
+	pb.RegisterDrcacheServer(g, s)
+	go g.Serve(lis)
+	t.Cleanup(g.Stop)
+	return &testNode{s, g}
+}
+
+func (n *testNode) entries(ns string) int64 {
+	space, _ := n.namespaces.get(ns)
+	return space.cache.EntryCount()
+}
+
+/*
+Starts two nodes forming a cluster.
+*/
+func startCluster(t *testing.T) (*testNode, *testNode) {
+	t.Helper()
+	first, second := startNode(t), startNode(t)
+	if err := first.Join(nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := second.Join([]string{first.selfAddress}); err != nil {
+		t.Fatal(err)
+	}
+	return first, second
+}
+
+func TestExpiredEntriesAreGone(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	if _, err := s.RPush(ctx, &pb.ListPushRequest{Key: "list", Values: [][]byte{[]byte("v")}, Expiration: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.BFCreate(ctx, &pb.BloomCreateRequest{Key: "filter", Capacity: 10, ErrorRate: 0.01, Expiration: 1}); err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"deleted", "added", "handed", "tagged"} {
+		if _, err := s.Set(ctx, &pb.SetRequest{Item: &pb.Item{Key: key, Value: []byte("v"), Expiration: 1, Tags: []string{"tag"}}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	time.Sleep(1100 * time.Millisecond)
+
+	if _, err := s.Set(ctx, &pb.SetRequest{Item: &pb.Item{Key: "list", Value: []byte("v")}}); err != nil {
+		t.Errorf("Set over an expired list returned %v", err)
+	}
+	if _, err := s.Delete(ctx, &pb.DeleteRequest{Key: "deleted"}); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Delete of an expired key returned %v", err)
+	}
+	if _, err := s.Add(ctx, &pb.AddRequest{Item: &pb.Item{Key: "added", Value: []byte("v")}}); err != nil {
+		t.Errorf("Add over an expired key returned %v", err)
+	}
+	if _, err := s.BFCreate(ctx, &pb.BloomCreateRequest{Key: "filter", Capacity: 10, ErrorRate: 0.01}); err != nil {
+		t.Errorf("BFCreate over an expired filter returned %v", err)
+	}
+	handed := (&entry{version: 1, value: []byte("live")}).encode()
+	if _, err := s.HandOff(peerContext(), &pb.HandOffRequest{Key: "handed", Entry: handed}); err != nil {
+		t.Errorf("HandOff over an expired key returned %v", err)
+	}
+	if value, err := getString(t, s, "handed"); err != nil || value != "live" {
+		t.Errorf("handed over key is %q, %v", value, err)
+	}
+	s.pruneTag("", "tagged", "tag")
+	for _, key := range s.tags.keys("", "tag") {
+		if key == "tagged" || key == "handed" {
+			t.Errorf("%v is still indexed under its tag", key)
+		}
+	}
+}
+
+/*
+Returns a context as a peer's request carries it.
+*/
+func peerContext() context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(peerMetadataKey, testClusterKey))
+}
+
+func TestDeleteAllDelay(t *testing.T) {
+	first, second := startCluster(t)
+	ctx := context.Background()
+	for _, local := range []bool{false, true} {
+		for i := 0; i < 10; i++ {
+			setString(t, first.Server, fmt.Sprint("key-", i), "v")
+		}
+		if _, err := second.DeleteAll(ctx, &pb.DeleteAllRequest{Delay: 1, Local: local}); err != nil {
+			t.Fatal(err)
+		}
+		if first.entries("")+second.entries("") != 10 {
+			t.Fatalf("local %v: flushed before the delay", local)
+		}
+		time.Sleep(1100 * time.Millisecond)
+		if local && (second.entries("") != 0 || first.entries("") == 0) {
+			t.Errorf("a local flush left %v keys on the node and %v on its peer", second.entries(""), first.entries(""))
+		} else if !local && first.entries("")+second.entries("") != 0 {
+			t.Errorf("%v keys left after the flush", first.entries("")+second.entries(""))
+		}
+	}
+}
+
+func TestDeleteAllRevokesLeasesAndNotifiesWatchers(t *testing.T) {
+	node := startNode(t)
+	if err := node.Join(nil); err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	setString(t, node.Server, "watched", "v")
+	events := watch(t, node, &pb.WatchRequest{Key: "watched"})
+	reply, err := node.Get(ctx, &pb.GetRequest{Key: "leased", Lease: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := node.DeleteAll(ctx, &pb.DeleteAllRequest{}); err != nil {
+		t.Fatal(err)
+	}
+	if event := nextEvent(t, events, time.Second); event.Type != pb.WatchEvent_DELETE || event.Item.Key != "watched" {
+		t.Errorf("event after the flush is %v", event)
+	}
+	_, err = node.Set(ctx, &pb.SetRequest{Item: &pb.Item{Key: "leased", Value: []byte("stale")}, Lease: reply.Lease})
+	if !errors.Is(err, ErrLeaseInvalid) {
+		t.Errorf("Set with a lease granted before the flush returned %v", err)
+	}
+}
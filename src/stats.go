@@ -0,0 +1,213 @@
+package src
+
+import (
+	"context"
+	pb "drcache/grpc/definitions"
+	lru "github.com/coocood/freecache"
+	"google.golang.org/grpc"
+	"path"
+	"sort"
+	"sync"
+	"time"
+)
+
+const bytesInterval = 10 * time.Second
+
+/*
+Request counts per RPC method.
+*/
+type counters struct {
+	counts map[string]uint64
+	sync.Mutex
+}
+
+func newCounters() *counters {
+	return &counters{counts: make(map[string]uint64)}
+}
+
+func (c *counters) inc(fullMethod string) {
+	c.Lock()
+	defer c.Unlock()
+	c.counts[path.Base(fullMethod)]++
+}
+
+func (c *counters) snapshot() map[string]uint64 {
+	c.Lock()
+	defer c.Unlock()
+	counts := make(map[string]uint64, len(c.counts))
+	for method, count := range c.counts {
+		counts[method] = count
+	}
+	return counts
+}
+
+/*
+Forwarded requests are those a node sends to the owner of a key on behalf of its callers. The cluster's own traffic is
+not counted: membership and handoff RPCs, and the per-node calls of cluster-wide operations, which are marked Local
+or are Stats queries of the peers.
+*/
+var internalMethods = map[string]bool{"Ping": true, "PingReq": true, "AddServer": true, "DropServer": true, "GetServers": true, "HandOff": true, "Stats": true}
+
+func forwarded(method string, req interface{}) bool {
+	if internalMethods[path.Base(method)] {
+		return false
+	}
+	local, ok := req.(interface{ GetLocal() bool })
+	return !ok || !local.GetLocal()
+}
+
+func (c *counters) countUnary(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if forwarded(method, req) {
+		c.inc(method)
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+/*
+The request of a stream is only known once it is sent, so the stream is counted then.
+*/
+func (c *counters) countStream(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	stream, err := streamer(ctx, desc, cc, method, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &countedStream{ClientStream: stream, counters: c, method: method}, nil
+}
+
+type countedStream struct {
+	grpc.ClientStream
+	counters *counters
+	method   string
+	once     sync.Once
+}
+
+func (s *countedStream) SendMsg(m interface{}) error {
+	s.once.Do(func() {
+		if forwarded(s.method, m) {
+			s.counters.inc(s.method)
+		}
+	})
+	return s.ClientStream.SendMsg(m)
+}
+
+/*
+CountUnary and CountStream count the requests served per RPC, install them on the grpc.Server serving s.
+*/
+func (s *Server) CountUnary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	s.requests.inc(info.FullMethod)
+	return handler(ctx, req)
+}
+
+func (s *Server) CountStream(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	s.requests.inc(info.FullMethod)
+	return handler(srv, stream)
+}
+
+/* Returns the cache counters, memory usage and request counts of the receiving node, or of every node and
+their sum in cluster mode. Nodes that could not be queried are listed with their error and left out of the sum.
+*/
+func (s *Server) Stats(ctx context.Context, in *pb.StatsRequest) (*pb.StatsReply, error) {
+	local := s.localStats()
+	if !in.Cluster {
+		return &pb.StatsReply{Nodes: []*pb.NodeStats{local}, Total: sumStats([]*pb.NodeStats{local})}, nil
+	}
+	peers := s.peers()
+	nodes := make([]*pb.NodeStats, len(peers)+1)
+	var wg sync.WaitGroup
+	for i, address := range peers {
+		wg.Add(1)
+		go func(i int, address string) {
+			defer wg.Done()
+			reply, err := s.client.Stats(address, &pb.StatsRequest{})
+			if err != nil || len(reply.Nodes) == 0 {
+				nodes[i] = &pb.NodeStats{Address: address, Error: errString(err, "Node returned no stats.")}
+				return
+			}
+			nodes[i] = reply.Nodes[0]
+		}(i, address)
+	}
+	nodes[len(peers)] = local
+	wg.Wait()
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Address < nodes[j].Address })
+	return &pb.StatsReply{Nodes: nodes, Total: sumStats(nodes)}, nil
+}
+
+func (s *Server) localStats() *pb.NodeStats {
+	stats := &pb.NodeStats{Address: s.selfAddress, Requests: s.requests.snapshot(), Forwarded: s.client.forwarded.snapshot()}
+	for name, space := range s.namespaces.all() {
+		ns := namespaceStats(name, space)
+		stats.Namespaces = append(stats.Namespaces, ns)
+		stats.Entries += ns.Entries
+		stats.Hits += ns.Hits
+		stats.Misses += ns.Misses
+		stats.Evictions += ns.Evictions
+		stats.Expirations += ns.Expirations
+		stats.Bytes += ns.Bytes
+		stats.Capacity += ns.Quota
+	}
+	sort.Slice(stats.Namespaces, func(i, j int) bool { return stats.Namespaces[i].Namespace < stats.Namespaces[j].Namespace })
+	return stats
+}
+
+func namespaceStats(name string, space *namespace) *pb.NamespaceStats {
+	return &pb.NamespaceStats{
+		Namespace:   name,
+		Quota:       space.quota,
+		Entries:     space.cache.EntryCount(),
+		Hits:        space.cache.HitCount(),
+		Misses:      space.cache.MissCount(),
+		Evictions:   space.cache.EvacuateCount(),
+		Expirations: space.cache.ExpiredCount(),
+		Bytes:       space.usedBytes(),
+	}
+}
+
+/*
+Measuring the memory usage walks and copies every entry of the namespace, as much as a full scan, so it is
+measured at most once per bytesInterval and the figure reported meanwhile may be that old.
+*/
+func (space *namespace) usedBytes() uint64 {
+	space.Lock()
+	defer space.Unlock()
+	if time.Since(space.measured) < bytesInterval {
+		return space.bytes
+	}
+	var bytes uint64
+	iterator := space.cache.NewIterator()
+	for item := iterator.Next(); item != nil; item = iterator.Next() {
+		bytes += uint64(lru.ENTRY_HDR_SIZE + len(item.Key) + len(item.Value))
+	}
+	space.bytes = bytes
+	space.measured = time.Now()
+	return bytes
+}
+
+func sumStats(nodes []*pb.NodeStats) *pb.NodeStats {
+	total := &pb.NodeStats{Requests: make(map[string]uint64), Forwarded: make(map[string]uint64)}
+	for _, node := range nodes {
+		if node.Error != "" {
+			continue
+		}
+		total.Entries += node.Entries
+		total.Hits += node.Hits
+		total.Misses += node.Misses
+		total.Evictions += node.Evictions
+		total.Expirations += node.Expirations
+		total.Bytes += node.Bytes
+		total.Capacity += node.Capacity
+		for method, count := range node.Requests {
+			total.Requests[method] += count
+		}
+		for method, count := range node.Forwarded {
+			total.Forwarded[method] += count
+		}
+	}
+	return total
+}
+
+func errString(err error, fallback string) string {
+	if err != nil {
+		return err.Error()
+	}
+	return fallback
+}
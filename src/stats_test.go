@@ -0,0 +1,78 @@
+package src
+
+import (
+	"context"
+	pb "drcache/grpc/definitions"
+	"fmt"
+	"testing"
+)
+
+/*
+Returns a key starting with prefix that the ring assigns to owner.
+*/
+func keyOwnedBy(t *testing.T, s *Server, owner string, prefix string) string {
+	t.Helper()
+	for i := 0; i < 1000; i++ {
+		if key := fmt.Sprint(prefix, i); s.ring().Get(key) == owner {
+			return key
+		}
+	}
+	t.Fatalf("no key is owned by %v", owner)
+	return ""
+}
+
+func TestStatsCountForwardedRequests(t *testing.T) {
+	first, second := startCluster(t)
+	ctx := context.Background()
+	remote := keyOwnedBy(t, first.Server, second.selfAddress, "key-")
+	missing := keyOwnedBy(t, first.Server, second.selfAddress, "missing-")
+	local := keyOwnedBy(t, first.Server, first.selfAddress, "key-")
+	before := first.client.forwarded.snapshot()
+	setString(t, first.Server, remote, "v")
+	setString(t, first.Server, local, "v")
+	getString(t, first.Server, remote)
+	getString(t, first.Server, missing)
+	if _, err := first.DeleteAll(ctx, &pb.DeleteAllRequest{Namespace: "", Delay: 60}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := first.InvalidateTag(ctx, &pb.InvalidateTagRequest{Tag: "tag"}); err != nil {
+		t.Fatal(err)
+	}
+
+	reply, err := first.Stats(ctx, &pb.StatsRequest{Cluster: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reply.Nodes) != 2 {
+		t.Fatalf("stats of %v nodes", len(reply.Nodes))
+	}
+	var firstStats *pb.NodeStats
+	for _, node := range reply.Nodes {
+		if node.Error != "" {
+			t.Errorf("%v: %v", node.Address, node.Error)
+		}
+		if node.Address == first.selfAddress {
+			firstStats = node
+		}
+	}
+	sent := make(map[string]uint64)
+	for method, count := range firstStats.Forwarded {
+		if count > before[method] {
+			sent[method] = count - before[method]
+		}
+	}
+	want := map[string]uint64{"Set": 1, "Get": 2}
+	if fmt.Sprint(sent) != fmt.Sprint(want) {
+		t.Errorf("forwarded %v, want %v", sent, want)
+	}
+	if reply.Total.Entries != 2 || reply.Total.Hits != 1 || reply.Total.Misses != 1 {
+		t.Errorf("total entries %v, hits %v, misses %v", reply.Total.Entries, reply.Total.Hits, reply.Total.Misses)
+	}
+	if reply.Total.Capacity != 2*minNamespaceQuota {
+		t.Errorf("total capacity %v", reply.Total.Capacity)
+	}
+	// requests forwarded by first are served by second
+	if served := reply.Nodes[0].Requests["Set"] + reply.Nodes[1].Requests["Set"]; served != 1 {
+		t.Errorf("%v Set requests served, want 1", served)
+	}
+}
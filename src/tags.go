@@ -0,0 +1,158 @@
+package src
+
+import (
+	"context"
+	pb "drcache/grpc/definitions"
+	"log"
+	"sync"
+	"time"
+)
+
+const tagSweepInterval = time.Minute
+
+/*
+Local index from tag to the keys carrying it, per namespace.
+The tags of an entry are stored in the entry itself, the index only finds candidates. freecache evicts and expires
+entries silently, so keys that are gone are removed by the periodic sweep or when their tag is invalidated.
+*/
+type tagIndex struct {
+	namespaces map[string]map[string]map[string]struct{} // namespace -> tag -> set of keys
+	sync.Mutex
+}
+
+func newTagIndex() *tagIndex {
+	return &tagIndex{namespaces: make(map[string]map[string]map[string]struct{})}
+}
+
+func (t *tagIndex) add(ns string, key string, tags []string) {
+	if len(tags) == 0 {
+		return
+	}
+	t.Lock()
+	defer t.Unlock()
+	index, ok := t.namespaces[ns]
+	if !ok {
+		index = make(map[string]map[string]struct{})
+		t.namespaces[ns] = index
+	}
+	for _, tag := range tags {
+		keys, ok := index[tag]
+		if !ok {
+			keys = make(map[string]struct{})
+			index[tag] = keys
+		}
+		keys[key] = struct{}{}
+	}
+}
+
+func (t *tagIndex) remove(ns string, key string, tags []string) {
+	if len(tags) == 0 {
+		return
+	}
+	t.Lock()
+	defer t.Unlock()
+	index := t.namespaces[ns]
+	for _, tag := range tags {
+		delete(index[tag], key)
+		if len(index[tag]) == 0 {
+			delete(index, tag)
+		}
+	}
+}
+
+func (t *tagIndex) keys(ns string, tag string) []string {
+	t.Lock()
+	defer t.Unlock()
+	var keys []string
+	for key := range t.namespaces[ns][tag] {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func (t *tagIndex) clear(ns string) {
+	t.Lock()
+	defer t.Unlock()
+	delete(t.namespaces, ns)
+}
+
+/*
+Returns a copy of the index, so that it can be walked without holding the lock.
+*/
+func (t *tagIndex) snapshot() map[string]map[string][]string {
+	t.Lock()
+	defer t.Unlock()
+	snapshot := make(map[string]map[string][]string, len(t.namespaces))
+	for ns, index := range t.namespaces {
+		snapshot[ns] = make(map[string][]string, len(index))
+		for tag, keys := range index {
+			for key := range keys {
+				snapshot[ns][tag] = append(snapshot[ns][tag], key)
+			}
+		}
+	}
+	return snapshot
+}
+
+/* Deletes every key carrying the tag in the namespace, on every node of the cluster.
+The reply tells how many keys every node dropped.
+*/
+func (s *Server) InvalidateTag(ctx context.Context, in *pb.InvalidateTagRequest) (*pb.ClusterReply, error) {
+	log.Printf("Received tag: %v", in.Tag)
+	if in.Local {
+		affected, err := s.invalidateLocal(in.Namespace, in.Tag)
+		if err != nil {
+			return nil, err
+		}
+		return &pb.ClusterReply{Nodes: []*pb.NodeResult{nodeResult(s.selfAddress, affected, nil)}}, nil
+	}
+	nodes := s.broadcast(func() (uint64, error) {
+		return s.invalidateLocal(in.Namespace, in.Tag)
+	}, func(address string) (uint64, error) {
+		return peerAffected(s.client.InvalidateTag(address, &pb.InvalidateTagRequest{Tag: in.Tag, Namespace: in.Namespace, Local: true}))
+	})
+	return &pb.ClusterReply{Nodes: nodes}, nil
+}
+
+func (s *Server) invalidateLocal(ns string, tag string) (uint64, error) {
+	if _, err := s.cache(ns); err != nil {
+		return 0, err
+	}
+	var affected uint64
+	for _, key := range s.tags.keys(ns, tag) {
+		if s.deleteLocalIf(ns, key, func(e *entry) bool { return e.hasTag(tag) }) {
+			affected++
+		} else {
+			s.pruneTag(ns, key, tag)
+		}
+	}
+	return affected, nil
+}
+
+/*
+Drops index entries of keys that were evicted, expired or rewritten without the tag.
+*/
+func (s *Server) sweepTags() {
+	for range time.Tick(tagSweepInterval) {
+		for ns, index := range s.tags.snapshot() {
+			for tag, keys := range index {
+				for _, key := range keys {
+					s.pruneTag(ns, key, tag)
+				}
+			}
+		}
+	}
+}
+
+/*
+Removes the key from the tag's index entry unless the key still carries the tag.
+*/
+func (s *Server) pruneTag(ns string, key string, tag string) {
+	lock := s.keyLock(key)
+	lock.Lock()
+	defer lock.Unlock()
+	e, err := s.peekLocal(ns, key)
+	if err != nil || !e.hasTag(tag) {
+		s.tags.remove(ns, key, []string{tag})
+	}
+}
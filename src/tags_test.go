@@ -0,0 +1,49 @@
+package src
+
+import (
+	"context"
+	pb "drcache/grpc/definitions"
+	"fmt"
+	"testing"
+)
+
+func TestInvalidateTagAcrossNodes(t *testing.T) {
+	first, second := startCluster(t)
+	ctx := context.Background()
+	for i := 0; i < 20; i++ {
+		item := &pb.Item{Key: fmt.Sprint("user:", i), Value: []byte("v"), Tags: []string{"users", fmt.Sprint("user-", i)}}
+		if _, err := first.Set(ctx, &pb.SetRequest{Item: item}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	setString(t, first.Server, "untagged", "v")
+	// rewritten without the tag, so not invalidated
+	if _, err := second.Set(ctx, &pb.SetRequest{Item: &pb.Item{Key: "user:0", Value: []byte("v"), Tags: []string{"other"}}}); err != nil {
+		t.Fatal(err)
+	}
+
+	reply, err := second.InvalidateTag(ctx, &pb.InvalidateTagRequest{Tag: "users"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var affected uint64
+	for _, node := range reply.Nodes {
+		if node.Error != "" {
+			t.Errorf("%v: %v", node.Address, node.Error)
+		}
+		affected += node.Affected
+	}
+	if len(reply.Nodes) != 2 || affected != 19 {
+		t.Errorf("%v nodes dropped %v keys, want 2 nodes and 19 keys", len(reply.Nodes), affected)
+	}
+	for i := 1; i < 20; i++ {
+		if _, err := getString(t, first.Server, fmt.Sprint("user:", i)); err == nil {
+			t.Errorf("user:%v was not invalidated", i)
+		}
+	}
+	for _, key := range []string{"user:0", "untagged"} {
+		if _, err := getString(t, first.Server, key); err != nil {
+			t.Errorf("%v: %v", key, err)
+		}
+	}
+}
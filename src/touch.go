@@ -0,0 +1,77 @@
+package src
+
+import (
+	"context"
+	pb "drcache/grpc/definitions"
+	"google.golang.org/grpc/status"
+	"log"
+)
+
+/* With consistent hashing check if key belongs to you, if so touch in local cache. Otherwise send to other server with client
+If entry does not exist, returns NotFound.
+If exists sets the entry's new expiration without rewriting its value, the reply item carries the new expiration.
+Like Set, it only applies to strings, so that the TTL of a lock or a rate limit bucket can not be shortened.
+*/
+func (s *Server) Touch(ctx context.Context, in *pb.TouchRequest) (*pb.Reply, error) {
+	log.Printf("Received: %v", in.Key)
+	nodeAddress := s.ring().Get(in.Key)
+	if nodeAddress == s.selfAddress {
+		item, err := s.touchLocal(in.Namespace, in.Key, in.Expiration)
+		if err != nil {
+			return nil, err
+		}
+		item.Value = nil
+		return &pb.Reply{Message: "ok", Item: item}, nil
+	} else {
+		reply, err := s.client.TouchItem(nodeAddress, in)
+		if status.Code(err) == 14 { // Connection Error server is down
+			s.suspectServer(nodeAddress)
+		}
+		return reply, err
+	}
+}
+
+/* With consistent hashing check if key belongs to you, if so touch in local cache. Otherwise send to other server with client
+Same as Touch, also returning the entry's value.
+*/
+func (s *Server) GetAndTouch(ctx context.Context, in *pb.TouchRequest) (*pb.Reply, error) {
+	log.Printf("Received: %v", in.Key)
+	nodeAddress := s.ring().Get(in.Key)
+	if nodeAddress == s.selfAddress {
+		item, err := s.touchLocal(in.Namespace, in.Key, in.Expiration)
+		if err != nil {
+			return nil, err
+		}
+		return &pb.Reply{Message: "ok", Item: item}, nil
+	} else {
+		reply, err := s.client.GetAndTouchItem(nodeAddress, in)
+		if status.Code(err) == 14 { // Connection Error server is down
+			s.suspectServer(nodeAddress)
+		}
+		return reply, err
+	}
+}
+
+func (s *Server) touchLocal(ns string, key string, expiration uint32) (*pb.Item, error) {
+	cache, err := s.cache(ns)
+	if err != nil {
+		return nil, err
+	}
+	lock := s.keyLock(key)
+	lock.Lock()
+	defer lock.Unlock()
+	// rewritten rather than touched in freecache, as the entry carries its expiry
+	e, err := s.peekLocal(ns, key)
+	if err != nil {
+		return nil, err
+	}
+	if e.kind != pb.Kind_STRING {
+		return nil, errWrongKind
+	}
+	e.expireAt = expiresAt(expiration)
+	if err := cache.Set([]byte(key), e.encode(), int(expiration)); err != nil {
+		return nil, cacheError(err)
+	}
+	s.notifyExpiration(ns, key, expiration)
+	return s.getLocal(ns, key)
+}
@@ -0,0 +1,48 @@
+package src
+
+import (
+	"context"
+	pb "drcache/grpc/definitions"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTouch(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	if _, err := s.Touch(ctx, &pb.TouchRequest{Key: "missing", Expiration: 10}); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Touch of a missing key returned %v", err)
+	}
+	set, err := s.Set(ctx, &pb.SetRequest{Item: &pb.Item{Key: "key", Value: []byte("v"), Expiration: 1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	touched, err := s.Touch(ctx, &pb.TouchRequest{Key: "key", Expiration: 60})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if touched.Item.Value != nil || touched.Item.LastUpdate != set.Item.LastUpdate || remainingTTL(touched.Item.Expiration) < 59 {
+		t.Errorf("Touch replied %v", touched.Item)
+	}
+	time.Sleep(1100 * time.Millisecond)
+	got, err := s.GetAndTouch(ctx, &pb.TouchRequest{Key: "key"})
+	if err != nil {
+		t.Fatalf("key expired with its old expiration: %v", err)
+	}
+	if string(got.Item.Value) != "v" || got.Item.Expiration != 0 {
+		t.Errorf("GetAndTouch replied %v", got.Item)
+	}
+}
+
+func TestTouchExpiredKey(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	if _, err := s.Set(ctx, &pb.SetRequest{Item: &pb.Item{Key: "key", Value: []byte("v"), Expiration: 1}}); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(1100 * time.Millisecond)
+	if _, err := s.Touch(ctx, &pb.TouchRequest{Key: "key", Expiration: 60}); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Touch revived an expired key: %v", err)
+	}
+}
@@ -0,0 +1,302 @@
+package src
+
+import (
+	"context"
+	pb "drcache/grpc/definitions"
+	"errors"
+	lru "github.com/coocood/freecache"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log"
+	"strings"
+	"sync"
+	"time"
+)
+
+var errWatcherTooSlow = &Error{codes.ResourceExhausted, "WATCHER_TOO_SLOW", "Watcher fell behind, events were dropped."}
+
+const watchBufferSize = 64
+
+type watcher struct {
+	namespace string
+	key       string
+	prefix    bool
+	events    chan *pb.WatchEvent
+	closed    bool
+	sync.Mutex
+}
+
+func (w *watcher) matches(ns string, key string) bool {
+	if w.namespace != ns {
+		return false
+	}
+	if w.prefix {
+		return strings.HasPrefix(key, w.key)
+	}
+	return key == w.key
+}
+
+/*
+Never blocks the writer. A watcher that does not keep up is closed and its stream ends with errWatcherTooSlow.
+*/
+func (w *watcher) send(event *pb.WatchEvent) {
+	w.Lock()
+	defer w.Unlock()
+	if w.closed {
+		return
+	}
+	select {
+	case w.events <- event:
+	default:
+		w.closed = true
+		close(w.events)
+	}
+}
+
+/*
+Local watchers of this node, and the expiration timers of the watched keys.
+freecache drops expired items silently, so a timer is armed for every watched key that is set with an expiration.
+Timers are keyed by namespacedKey.
+*/
+type watchHub struct {
+	watchers map[*watcher]struct{}
+	timers   map[string]*time.Timer
+	sync.Mutex
+}
+
+func newWatchHub() *watchHub {
+	return &watchHub{watchers: make(map[*watcher]struct{}), timers: make(map[string]*time.Timer)}
+}
+
+func (h *watchHub) subscribe(ns string, key string, prefix bool) *watcher {
+	h.Lock()
+	defer h.Unlock()
+	w := &watcher{namespace: ns, key: key, prefix: prefix, events: make(chan *pb.WatchEvent, watchBufferSize)}
+	h.watchers[w] = struct{}{}
+	return w
+}
+
+func (h *watchHub) unsubscribe(w *watcher) {
+	h.Lock()
+	defer h.Unlock()
+	delete(h.watchers, w)
+}
+
+func (h *watchHub) publish(ns string, event *pb.WatchEvent) {
+	h.Lock()
+	defer h.Unlock()
+	for w := range h.watchers {
+		if w.matches(ns, event.Item.Key) {
+			w.send(event)
+		}
+	}
+}
+
+/*
+Reports whether any watcher of the namespace is subscribed.
+*/
+func (h *watchHub) watching(ns string) bool {
+	h.Lock()
+	defer h.Unlock()
+	for w := range h.watchers {
+		if w.namespace == ns {
+			return true
+		}
+	}
+	return false
+}
+
+func (h *watchHub) watched(ns string, key string) bool {
+	h.Lock()
+	defer h.Unlock()
+	for w := range h.watchers {
+		if w.matches(ns, key) {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+Publishes an EXPIRE event for key once its expiration has passed, unless the key is written or deleted before.
+*/
+func (h *watchHub) expireAfter(ns string, key string, seconds uint32, expired func() bool) {
+	h.Lock()
+	defer h.Unlock()
+	id := namespacedKey(ns, key)
+	if timer, ok := h.timers[id]; ok {
+		timer.Stop()
+	}
+	var timer *time.Timer
+	timer = time.AfterFunc(time.Duration(seconds)*time.Second, func() {
+		h.Lock()
+		current := h.timers[id] == timer
+		if current {
+			delete(h.timers, id)
+		}
+		h.Unlock()
+		if current && expired() {
+			h.publish(ns, &pb.WatchEvent{Type: pb.WatchEvent_EXPIRE, Item: &pb.Item{Key: key}})
+		}
+	})
+	h.timers[id] = timer
+}
+
+func (h *watchHub) cancelExpiry(ns string, key string) {
+	h.Lock()
+	defer h.Unlock()
+	id := namespacedKey(ns, key)
+	if timer, ok := h.timers[id]; ok {
+		timer.Stop()
+		delete(h.timers, id)
+	}
+}
+
+/* Streams SET, DELETE and EXPIRE events of a key, or of every key under a prefix.
+A key watch is proxied to the key's owner. Keys under a prefix live on every node, so a prefix watch
+subscribes locally and relays the local watches of all peers.
+A watch is set up from the ring, so it ends with ErrRingChanged once the key moves to another owner, or for a
+prefix watch once the members change, and the client subscribes again.
+*/
+func (s *Server) Watch(in *pb.WatchRequest, stream pb.Drcache_WatchServer) error {
+	log.Printf("Received watch: %v", in.Key)
+	if !in.Prefix && !in.Local {
+		nodeAddress := s.ring().Get(in.Key)
+		if nodeAddress != s.selfAddress {
+			return s.proxyWatch(nodeAddress, in, stream)
+		}
+	}
+	cache, err := s.cache(in.Namespace)
+	if err != nil {
+		return err
+	}
+	w := s.watchers.subscribe(in.Namespace, in.Key, in.Prefix)
+	defer s.watchers.unsubscribe(w)
+	s.armExpiries(in.Namespace, cache, w)
+
+	var ctx context.Context
+	var cancel context.CancelFunc
+	moved := func() bool { return false }
+	switch {
+	case in.Local:
+		ctx, cancel = context.WithCancel(stream.Context())
+	case in.Prefix:
+		ctx, cancel, moved = s.cancelOnMove(stream.Context(), func() bool { return true })
+	default:
+		ctx, cancel, moved = s.cancelOnMove(stream.Context(), func() bool { return s.ring().Get(in.Key) != s.selfAddress })
+	}
+	defer cancel()
+	relayErrors := make(chan error, 1)
+	if in.Prefix && !in.Local {
+		for _, address := range s.peers() {
+			go func(address string) {
+				err := s.relayWatch(ctx, address, &pb.WatchRequest{Key: in.Key, Prefix: true, Local: true, Namespace: in.Namespace}, w.send)
+				if ctx.Err() == nil {
+					select {
+					case relayErrors <- err:
+					default:
+					}
+				}
+			}(address)
+		}
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			if moved() {
+				return ErrRingChanged
+			}
+			return ctx.Err()
+		case err := <-relayErrors:
+			return err
+		case event, ok := <-w.events:
+			if !ok {
+				return errWatcherTooSlow
+			}
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *Server) proxyWatch(address string, in *pb.WatchRequest, stream pb.Drcache_WatchServer) error {
+	ctx, cancel, moved := s.cancelOnMove(stream.Context(), func() bool { return s.ring().Get(in.Key) != address })
+	defer cancel()
+	err := s.relayWatch(ctx, address, in, func(event *pb.WatchEvent) {
+		stream.Send(event)
+	})
+	if moved() {
+		return ErrRingChanged
+	}
+	return err
+}
+
+/*
+Opens a watch on address and hands every event to deliver until the stream or ctx ends.
+*/
+func (s *Server) relayWatch(ctx context.Context, address string, in *pb.WatchRequest, deliver func(*pb.WatchEvent)) error {
+	remote, err := s.client.WatchItems(ctx, address, in)
+	for err == nil {
+		var event *pb.WatchEvent
+		event, err = remote.Recv()
+		if err == nil {
+			deliver(event)
+		}
+	}
+	if status.Code(err) == 14 && !errors.Is(err, ErrRingChanged) { // Connection Error server is down
+		s.suspectServer(address)
+	}
+	return err
+}
+
+/*
+Arms the expiration timers of the keys w matches that already exist with an expiration, since they were
+written before anyone watched them. A prefix watch scans the namespace for them.
+*/
+func (s *Server) armExpiries(ns string, cache *lru.Cache, w *watcher) {
+	if !w.prefix {
+		if e, err := s.peekLocal(ns, w.key); err == nil && e.expireAt != 0 {
+			s.notifyExpiration(ns, w.key, remainingTTL(e.expireAt))
+		}
+		return
+	}
+	iterator := cache.NewIterator()
+	for item := iterator.Next(); item != nil; item = iterator.Next() {
+		key := string(item.Key)
+		if !strings.HasPrefix(key, w.key) {
+			continue
+		}
+		if e, err := decodeEntry(item.Value); err == nil && e.expireAt != 0 && !e.expired() {
+			s.notifyExpiration(ns, key, remainingTTL(e.expireAt))
+		}
+	}
+}
+
+/*
+Publishes the write to local watchers, and arms the expiration timer if the key is watched.
+*/
+func (s *Server) notifySet(ns string, item *pb.Item, expiration uint32) {
+	s.notifyExpiration(ns, item.Key, expiration)
+	s.watchers.publish(ns, &pb.WatchEvent{Type: pb.WatchEvent_SET, Item: item})
+}
+
+/*
+Arms the expiration timer of a watched key, or disarms it when the key no longer expires.
+The timer fires in the second the entry expires, peekLocal then reports the key missing.
+*/
+func (s *Server) notifyExpiration(ns string, key string, expiration uint32) {
+	if expiration > 0 && s.watchers.watched(ns, key) {
+		s.watchers.expireAfter(ns, key, expiration, func() bool {
+			_, err := s.peekLocal(ns, key)
+			return err == ErrNotFound
+		})
+	} else {
+		s.watchers.cancelExpiry(ns, key)
+	}
+}
+
+func (s *Server) notifyDelete(ns string, key string) {
+	s.watchers.cancelExpiry(ns, key)
+	s.watchers.publish(ns, &pb.WatchEvent{Type: pb.WatchEvent_DELETE, Item: &pb.Item{Key: key}})
+}
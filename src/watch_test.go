@@ -0,0 +1,101 @@
+package src
+
+import (
+	"context"
+	pb "drcache/grpc/definitions"
+	"testing"
+	"time"
+)
+
+/*
+Opens a watch on the node and returns its events, the channel is closed when the stream ends.
+*/
+func watch(t *testing.T, node *testNode, in *pb.WatchRequest) <-chan *pb.WatchEvent {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	stream, err := node.client.WatchItems(ctx, node.selfAddress, in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	events := make(chan *pb.WatchEvent, watchBufferSize)
+	go func() {
+		defer close(events)
+		for {
+			event, err := stream.Recv()
+			if err != nil {
+				return
+			}
+			events <- event
+		}
+	}()
+	// the stream is set up once the node has the watcher
+	for deadline := time.Now().Add(time.Second); !node.watchers.watched(in.Namespace, in.Key) && time.Now().Before(deadline); {
+		time.Sleep(time.Millisecond)
+	}
+	return events
+}
+
+func nextEvent(t *testing.T, events <-chan *pb.WatchEvent, timeout time.Duration) *pb.WatchEvent {
+	t.Helper()
+	select {
+	case event, ok := <-events:
+		if !ok {
+			t.Fatal("watch ended")
+		}
+		return event
+	case <-time.After(timeout):
+		t.Fatal("no event within", timeout)
+	}
+	return nil
+}
+
+func TestWatchKey(t *testing.T) {
+	node := startNode(t)
+	if err := node.Join(nil); err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	events := watch(t, node, &pb.WatchRequest{Key: "key"})
+	setString(t, node.Server, "other", "v")
+	setString(t, node.Server, "key", "v")
+	if event := nextEvent(t, events, time.Second); event.Type != pb.WatchEvent_SET || event.Item.Key != "key" || string(event.Item.Value) != "v" {
+		t.Errorf("first event is %v", event)
+	}
+	if _, err := node.Delete(ctx, &pb.DeleteRequest{Key: "key"}); err != nil {
+		t.Fatal(err)
+	}
+	if event := nextEvent(t, events, time.Second); event.Type != pb.WatchEvent_DELETE {
+		t.Errorf("second event is %v", event)
+	}
+}
+
+func TestWatchExpire(t *testing.T) {
+	node := startNode(t)
+	if err := node.Join(nil); err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	// written before the watch starts
+	if _, err := node.Set(ctx, &pb.SetRequest{Item: &pb.Item{Key: "session:old", Value: []byte("v"), Expiration: 1}}); err != nil {
+		t.Fatal(err)
+	}
+	events := watch(t, node, &pb.WatchRequest{Key: "session:", Prefix: true})
+	if _, err := node.Set(ctx, &pb.SetRequest{Item: &pb.Item{Key: "session:new", Value: []byte("v"), Expiration: 1}}); err != nil {
+		t.Fatal(err)
+	}
+	if event := nextEvent(t, events, time.Second); event.Type != pb.WatchEvent_SET || event.Item.Key != "session:new" {
+		t.Errorf("first event is %v", event)
+	}
+	expired := make(map[string]bool)
+	for len(expired) < 2 {
+		event := nextEvent(t, events, 3*time.Second)
+		if event.Type != pb.WatchEvent_EXPIRE {
+			t.Fatalf("event %v before the keys expired", event)
+		}
+		expired[event.Item.Key] = true
+	}
+	if !expired["session:old"] || !expired["session:new"] {
+		t.Errorf("expired keys are %v", expired)
+	}
+}
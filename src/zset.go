@@ -0,0 +1,300 @@
+package src
+
+import (
+	"context"
+	pb "drcache/grpc/definitions"
+	"encoding/binary"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log"
+	"math"
+	"sort"
+)
+
+var errNaNScore = &Error{codes.InvalidArgument, "NAN_SCORE", "Score is not a number."}
+
+/* With consistent hashing check if key belongs to you, if so add to the sorted set in local cache. Otherwise send to other server with client
+Adds the members or replaces their scores, creating the sorted set if it does not exist. The reply counts the members that were added.
+*/
+func (s *Server) ZAdd(ctx context.Context, in *pb.SortedSetAddRequest) (*pb.SortedSetReply, error) {
+	log.Printf("Received: %v", in.Key)
+	nodeAddress := s.ring().Get(in.Key)
+	if nodeAddress == s.selfAddress {
+		for _, member := range in.Members {
+			if math.IsNaN(member.Score) {
+				return nil, errNaNScore
+			}
+		}
+		reply := &pb.SortedSetReply{}
+		item, err := s.updateSortedSet(in.Namespace, in.Key, in.Expiration, func(members map[string]float64) error {
+			for _, member := range in.Members {
+				if _, ok := members[member.Member]; !ok {
+					reply.Count++
+				}
+				members[member.Member] = member.Score
+			}
+			reply.Length = uint64(len(members))
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		reply.LastUpdate = item.LastUpdate
+		return reply, nil
+	} else {
+		reply, err := s.client.ZAddItem(nodeAddress, in)
+		if status.Code(err) == 14 { // Connection Error server is down
+			s.suspectServer(nodeAddress)
+		}
+		return reply, err
+	}
+}
+
+/* With consistent hashing check if key belongs to you, if so update the sorted set in local cache. Otherwise send to other server with client
+Adds the delta to the score of the member, creating the member and the sorted set if they do not exist.
+*/
+func (s *Server) ZIncrBy(ctx context.Context, in *pb.SortedSetIncrRequest) (*pb.SortedSetReply, error) {
+	log.Printf("Received: %v", in.Key)
+	nodeAddress := s.ring().Get(in.Key)
+	if nodeAddress == s.selfAddress {
+		reply := &pb.SortedSetReply{}
+		item, err := s.updateSortedSet(in.Namespace, in.Key, in.Expiration, func(members map[string]float64) error {
+			score := members[in.Member] + in.Delta
+			if math.IsNaN(score) {
+				return errNaNScore
+			}
+			members[in.Member] = score
+			reply.Score = score
+			reply.Length = uint64(len(members))
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		reply.LastUpdate = item.LastUpdate
+		return reply, nil
+	} else {
+		reply, err := s.client.ZIncrByItem(nodeAddress, in)
+		if status.Code(err) == 14 { // Connection Error server is down
+			s.suspectServer(nodeAddress)
+		}
+		return reply, err
+	}
+}
+
+/* With consistent hashing check if key belongs to you, if so read the sorted set from local cache. Otherwise send to other server with client
+Returns the members ranked from start to stop, both inclusive. A missing sorted set has no members.
+*/
+func (s *Server) ZRange(ctx context.Context, in *pb.SortedSetRangeRequest) (*pb.SortedSetReply, error) {
+	nodeAddress := s.ring().Get(in.Key)
+	if nodeAddress == s.selfAddress {
+		members, lastUpdate, err := s.readSortedSet(in.Namespace, in.Key)
+		if err != nil {
+			return nil, err
+		}
+		if in.Reverse {
+			reverseMembers(members)
+		}
+		start, stop := rankRange(in.Start, in.Stop, len(members))
+		return &pb.SortedSetReply{Members: members[start:stop], LastUpdate: lastUpdate, Length: uint64(len(members))}, nil
+	} else {
+		reply, err := s.client.ZRangeItems(nodeAddress, in)
+		if status.Code(err) == 14 { // Connection Error server is down
+			s.suspectServer(nodeAddress)
+		}
+		return reply, err
+	}
+}
+
+/* With consistent hashing check if key belongs to you, if so read the sorted set from local cache. Otherwise send to other server with client
+Returns the members with a score between min and max, both inclusive. A missing sorted set has no members.
+*/
+func (s *Server) ZRangeByScore(ctx context.Context, in *pb.SortedSetScoreRequest) (*pb.SortedSetReply, error) {
+	nodeAddress := s.ring().Get(in.Key)
+	if nodeAddress == s.selfAddress {
+		members, lastUpdate, err := s.readSortedSet(in.Namespace, in.Key)
+		if err != nil {
+			return nil, err
+		}
+		reply := &pb.SortedSetReply{LastUpdate: lastUpdate, Length: uint64(len(members))}
+		if in.Reverse {
+			reverseMembers(members)
+		}
+		for _, member := range members {
+			if in.Limit > 0 && len(reply.Members) == int(in.Limit) {
+				break
+			}
+			if member.Score >= in.Min && member.Score <= in.Max {
+				reply.Members = append(reply.Members, member)
+			}
+		}
+		return reply, nil
+	} else {
+		reply, err := s.client.ZRangeByScoreItems(nodeAddress, in)
+		if status.Code(err) == 14 { // Connection Error server is down
+			s.suspectServer(nodeAddress)
+		}
+		return reply, err
+	}
+}
+
+/* With consistent hashing check if key belongs to you, if so update the sorted set in local cache. Otherwise send to other server with client
+Removes the members ranked from start to stop, both inclusive. The sorted set is deleted with its last member.
+*/
+func (s *Server) ZRemRangeByRank(ctx context.Context, in *pb.SortedSetRangeRequest) (*pb.SortedSetReply, error) {
+	log.Printf("Received: %v", in.Key)
+	nodeAddress := s.ring().Get(in.Key)
+	if nodeAddress == s.selfAddress {
+		return s.removeFromSortedSet(in.Namespace, in.Key, func(members []*pb.SortedSetMember) []*pb.SortedSetMember {
+			start, stop := rankRange(in.Start, in.Stop, len(members))
+			return members[start:stop]
+		})
+	} else {
+		reply, err := s.client.ZRemRangeByRankItems(nodeAddress, in)
+		if status.Code(err) == 14 { // Connection Error server is down
+			s.suspectServer(nodeAddress)
+		}
+		return reply, err
+	}
+}
+
+/* With consistent hashing check if key belongs to you, if so update the sorted set in local cache. Otherwise send to other server with client
+Removes the members with a score between min and max, both inclusive. The sorted set is deleted with its last member.
+*/
+func (s *Server) ZRemRangeByScore(ctx context.Context, in *pb.SortedSetScoreRequest) (*pb.SortedSetReply, error) {
+	log.Printf("Received: %v", in.Key)
+	nodeAddress := s.ring().Get(in.Key)
+	if nodeAddress == s.selfAddress {
+		return s.removeFromSortedSet(in.Namespace, in.Key, func(members []*pb.SortedSetMember) []*pb.SortedSetMember {
+			var removed []*pb.SortedSetMember
+			for _, member := range members {
+				if member.Score >= in.Min && member.Score <= in.Max {
+					removed = append(removed, member)
+				}
+			}
+			return removed
+		})
+	} else {
+		reply, err := s.client.ZRemRangeByScoreItems(nodeAddress, in)
+		if status.Code(err) == 14 { // Connection Error server is down
+			s.suspectServer(nodeAddress)
+		}
+		return reply, err
+	}
+}
+
+/*
+Applies update to the members of a local sorted set, kept as a member to score map while it runs.
+*/
+func (s *Server) updateSortedSet(ns string, key string, expiration uint32, update func(map[string]float64) error) (*pb.Item, error) {
+	return s.updateKind(ns, key, pb.Kind_SORTED_SET, expiration, func(raw []byte) ([]byte, error) {
+		sorted, err := decodeSortedSet(raw)
+		if err != nil {
+			return nil, err
+		}
+		members := make(map[string]float64, len(sorted))
+		for _, member := range sorted {
+			members[member.Member] = member.Score
+		}
+		if err := update(members); err != nil {
+			return nil, err
+		}
+		sorted = sorted[:0]
+		for member, score := range members {
+			sorted = append(sorted, &pb.SortedSetMember{Member: member, Score: score})
+		}
+		sortMembers(sorted)
+		return encodeSortedSet(sorted), nil
+	})
+}
+
+/*
+Removes from a local sorted set the members that selected picks out of its ordered members.
+*/
+func (s *Server) removeFromSortedSet(ns string, key string, selected func([]*pb.SortedSetMember) []*pb.SortedSetMember) (*pb.SortedSetReply, error) {
+	reply := &pb.SortedSetReply{}
+	item, err := s.updateSortedSet(ns, key, 0, func(members map[string]float64) error {
+		sorted := make([]*pb.SortedSetMember, 0, len(members))
+		for member, score := range members {
+			sorted = append(sorted, &pb.SortedSetMember{Member: member, Score: score})
+		}
+		sortMembers(sorted)
+		for _, member := range selected(sorted) {
+			delete(members, member.Member)
+			reply.Count++
+		}
+		reply.Length = uint64(len(members))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	reply.LastUpdate = item.LastUpdate
+	return reply, nil
+}
+
+func (s *Server) readSortedSet(ns string, key string) ([]*pb.SortedSetMember, uint64, error) {
+	item, err := s.readKind(ns, key, pb.Kind_SORTED_SET)
+	if err == ErrNotFound {
+		return nil, 0, nil
+	} else if err != nil {
+		return nil, 0, err
+	}
+	members, err := decodeSortedSet(item.Value)
+	return members, item.LastUpdate, err
+}
+
+/*
+Orders members by score, then by member for equal scores.
+*/
+func sortMembers(members []*pb.SortedSetMember) {
+	sort.Slice(members, func(i, j int) bool {
+		if members[i].Score != members[j].Score {
+			return members[i].Score < members[j].Score
+		}
+		return members[i].Member < members[j].Member
+	})
+}
+
+func reverseMembers(members []*pb.SortedSetMember) {
+	for i, j := 0, len(members)-1; i < j; i, j = i+1, j-1 {
+		members[i], members[j] = members[j], members[i]
+	}
+}
+
+/*
+Layout: for every member, in order, its score (8 bytes, IEEE 754), the member's length (uvarint) and bytes.
+An empty sorted set encodes to nothing, so that updateKind deletes it.
+*/
+func encodeSortedSet(members []*pb.SortedSetMember) []byte {
+	size := 0
+	for _, member := range members {
+		size += 8 + binary.MaxVarintLen64 + len(member.Member)
+	}
+	buf := make([]byte, size)
+	n := 0
+	for _, member := range members {
+		binary.BigEndian.PutUint64(buf[n:], math.Float64bits(member.Score))
+		n += 8
+		n += binary.PutUvarint(buf[n:], uint64(len(member.Member)))
+		n += copy(buf[n:], member.Member)
+	}
+	return buf[:n]
+}
+
+func decodeSortedSet(raw []byte) ([]*pb.SortedSetMember, error) {
+	var members []*pb.SortedSetMember
+	for len(raw) > 0 {
+		if len(raw) < 8 {
+			return nil, errCorruptEntry
+		}
+		score := math.Float64frombits(binary.BigEndian.Uint64(raw))
+		member, rest, err := readChunk(raw[8:])
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, &pb.SortedSetMember{Member: string(member), Score: score})
+		raw = rest
+	}
+	return members, nil
+}
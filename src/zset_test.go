@@ -0,0 +1,94 @@
+package src
+
+import (
+	"context"
+	pb "drcache/grpc/definitions"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func memberList(members []*pb.SortedSetMember) string {
+	list := make([]string, len(members))
+	for i, member := range members {
+		list[i] = fmt.Sprint(member.Member, ":", member.Score)
+	}
+	return fmt.Sprint(list)
+}
+
+func TestSortedSetLeaderboard(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	members := []*pb.SortedSetMember{{Member: "ada", Score: 30}, {Member: "bob", Score: 10}, {Member: "cy", Score: 20}}
+	reply, err := s.ZAdd(ctx, &pb.SortedSetAddRequest{Key: "board", Members: members})
+	if err != nil || reply.Count != 3 {
+		t.Fatalf("ZAdd returned %v, %v", reply, err)
+	}
+	if reply, err = s.ZAdd(ctx, &pb.SortedSetAddRequest{Key: "board", Members: []*pb.SortedSetMember{{Member: "bob", Score: 25}}}); err != nil || reply.Count != 0 {
+		t.Errorf("ZAdd of an existing member returned %v, %v", reply, err)
+	}
+	if reply, err = s.ZIncrBy(ctx, &pb.SortedSetIncrRequest{Key: "board", Member: "cy", Delta: 15}); err != nil || reply.Score != 35 {
+		t.Errorf("ZIncrBy returned %v, %v", reply, err)
+	}
+	if reply, err = s.ZRange(ctx, &pb.SortedSetRangeRequest{Key: "board", Start: 0, Stop: -1}); err != nil || memberList(reply.Members) != "[bob:25 ada:30 cy:35]" {
+		t.Errorf("ZRange returned %v, %v", reply, err)
+	}
+	if reply, err = s.ZRange(ctx, &pb.SortedSetRangeRequest{Key: "board", Start: 0, Stop: 1, Reverse: true}); err != nil || memberList(reply.Members) != "[cy:35 ada:30]" {
+		t.Errorf("reverse ZRange of the top two returned %v, %v", reply, err)
+	}
+	if reply, err = s.ZRemRangeByRank(ctx, &pb.SortedSetRangeRequest{Key: "board", Start: 0, Stop: 0}); err != nil || reply.Count != 1 || reply.Length != 2 {
+		t.Errorf("ZRemRangeByRank of the lowest returned %v, %v", reply, err)
+	}
+	if reply, err = s.ZRange(ctx, &pb.SortedSetRangeRequest{Key: "board", Start: 0, Stop: -1}); err != nil || memberList(reply.Members) != "[ada:30 cy:35]" {
+		t.Errorf("ZRange after the removal returned %v, %v", reply, err)
+	}
+}
+
+func TestSortedSetRateWindow(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	for i := 1; i <= 5; i++ {
+		member := &pb.SortedSetMember{Member: fmt.Sprint("request-", i), Score: float64(i * 100)}
+		if _, err := s.ZAdd(ctx, &pb.SortedSetAddRequest{Key: "window", Members: []*pb.SortedSetMember{member}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	reply, err := s.ZRemRangeByScore(ctx, &pb.SortedSetScoreRequest{Key: "window", Min: 0, Max: 200})
+	if err != nil || reply.Count != 2 || reply.Length != 3 {
+		t.Fatalf("ZRemRangeByScore of the old requests returned %v, %v", reply, err)
+	}
+	if reply, err = s.ZRangeByScore(ctx, &pb.SortedSetScoreRequest{Key: "window", Min: 300, Max: 400}); err != nil || memberList(reply.Members) != "[request-3:300 request-4:400]" {
+		t.Errorf("ZRangeByScore returned %v, %v", reply, err)
+	}
+	if reply, err = s.ZRangeByScore(ctx, &pb.SortedSetScoreRequest{Key: "window", Min: 0, Max: 1000, Reverse: true, Limit: 1}); err != nil || memberList(reply.Members) != "[request-5:500]" {
+		t.Errorf("ZRangeByScore of the newest returned %v, %v", reply, err)
+	}
+	if _, err := s.ZRemRangeByScore(ctx, &pb.SortedSetScoreRequest{Key: "window", Min: 0, Max: 1000}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := getString(t, s, "window"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("sorted set without members was kept: %v", err)
+	}
+}
+
+func TestSortedSetWrongKind(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	setString(t, s, "text", "value")
+	if _, err := s.ZIncrBy(ctx, &pb.SortedSetIncrRequest{Key: "text", Member: "a", Delta: 1}); !errors.Is(err, errWrongKind) {
+		t.Errorf("ZIncrBy of a string returned %v", err)
+	}
+	if _, err := s.ZRange(ctx, &pb.SortedSetRangeRequest{Key: "text", Stop: -1}); !errors.Is(err, errWrongKind) {
+		t.Errorf("ZRange of a string returned %v", err)
+	}
+}
+
+func TestSortMembers(t *testing.T) {
+	members := []*pb.SortedSetMember{{Member: "c", Score: 1}, {Member: "b", Score: 0}, {Member: "a", Score: 1}}
+	sortMembers(members)
+	for i, want := range []string{"b", "a", "c"} {
+		if members[i].Member != want {
+			t.Fatalf("member %v is %v, want %v", i, members[i].Member, want)
+		}
+	}
+}